@@ -0,0 +1,11 @@
+data "octopusdeploy_evaluated_expression" "hosts" {
+  template = "#{each region in Regions}#{region}.example.com#{unless Octopus.Template.Each.Last},#{/unless}#{/each}"
+  variables = {
+    Regions = "us-east,eu-west"
+  }
+}
+
+# "us-east.example.com,eu-west.example.com"
+output "hosts" {
+  value = data.octopusdeploy_evaluated_expression.hosts.result
+}
@@ -0,0 +1,19 @@
+resource "octopusdeploy_user" "automation" {
+  display_name = "Release Automation"
+  username     = "release-automation"
+  is_service   = true
+}
+
+ephemeral "octopusdeploy_api_key" "automation" {
+  user_id    = octopusdeploy_user.automation.id
+  purpose    = "Release automation"
+  expires_in = "1h"
+}
+
+# A second provider configuration that authenticates as the service account
+provider "octopusdeploy" {
+  alias    = "automation"
+  address  = "https://octopus.example.com"
+  api_key  = ephemeral.octopusdeploy_api_key.automation.api_key
+  space_id = "Spaces-1"
+}
@@ -0,0 +1,10 @@
+ephemeral "octopusdeploy_tentacle_certificate" "agent" {
+  valid_for = "8760h"
+}
+
+# The certificate is only available to ephemeral contexts, such as write-only attributes
+resource "octopusdeploy_certificate" "agent" {
+  name                        = "Kubernetes agent"
+  certificate_data_wo         = ephemeral.octopusdeploy_tentacle_certificate.agent.base64
+  certificate_data_wo_version = 1
+}
@@ -0,0 +1,10 @@
+output "connection_string" {
+  # "Server=db.example.com;Database=APP"
+  value = provider::octopusdeploy::evaluate_expression(
+    "Server=#{DatabaseServer};Database=#{DatabaseName | ToUpper}",
+    {
+      DatabaseServer = "db.example.com"
+      DatabaseName   = "app"
+    }
+  )
+}
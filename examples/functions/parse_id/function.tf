@@ -0,0 +1,7 @@
+locals {
+  project = provider::octopusdeploy::parse_id("Projects-123")
+}
+
+output "project_number" {
+  value = local.project.number # 123
+}
@@ -0,0 +1,3 @@
+output "project_group_slug" {
+  value = provider::octopusdeploy::slugify("Web Apps & APIs") # "web-apps-apis"
+}
@@ -0,0 +1,6 @@
+output "lifecycle_ids" {
+  value = [
+    provider::octopusdeploy::space_qualified_id("Spaces-1", "Lifecycles-7"), # "Spaces-1/Lifecycles-7"
+    provider::octopusdeploy::space_qualified_id("Spaces-2", "Lifecycles-7"), # "Spaces-2/Lifecycles-7"
+  ]
+}
@@ -0,0 +1,4 @@
+resource "octopusdeploy_tenant" "europe" {
+  name        = "Europe"
+  tenant_tags = [provider::octopusdeploy::tenant_tag("Regions", "Europe")] # "Regions/Europe"
+}
@@ -0,0 +1,9 @@
+variable "package_version" {
+  type = string
+
+  validation {
+    # Releases of version 1.x only, which is what the "Stable" channel accepts
+    condition     = provider::octopusdeploy::version_matches_channel_rule(var.package_version, "[1.0,2.0)", "^$")
+    error_message = "The package version must be a 1.x release to be deployed through the Stable channel."
+  }
+}
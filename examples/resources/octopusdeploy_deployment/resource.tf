@@ -0,0 +1,17 @@
+resource "octopusdeploy_release" "example" {
+  project_id = "Projects-123"
+}
+
+resource "octopusdeploy_deployment" "example" {
+  release_id     = octopusdeploy_release.example.id
+  environment_id = "Environments-123"
+  tenant_ids     = ["Tenants-123", "Tenants-456"]
+
+  guided_failure_mode = "Off"
+  skip_step_names     = ["Notify Slack"]
+  timeout             = "1h"
+
+  variables = {
+    "Database Password" = var.database_password
+  }
+}
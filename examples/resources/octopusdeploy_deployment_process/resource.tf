@@ -118,3 +118,117 @@ resource "octopusdeploy_deployment_process" "child_step_rolling_deployment_examp
   }
 }
 
+
+# deployment process that upgrades a Helm chart with values from a package, inline YAML and explicit key values
+resource "octopusdeploy_deployment_process" "helm_example" {
+  project_id = "Projects-123"
+  step {
+    name         = "Upgrade web chart"
+    target_roles = [ "k8s" ]
+    deploy_helm_chart_action {
+      name                = "Upgrade web chart"
+      release_name        = "web"
+      namespace           = "web-#{Octopus.Environment.Name | ToLower}"
+      helm_client_version = "V3"
+      timeout             = "5m0s"
+      yaml_values         = <<-EOT
+          ingress:
+            enabled: true
+        EOT
+      key_values = {
+        "image.tag" = "#{Octopus.Release.Number}"
+      }
+      primary_package {
+        feed_id    = "Feeds-123"
+        package_id = "web-chart"
+      }
+      values_package {
+        name             = "values"
+        feed_id          = "Feeds-456"
+        package_id       = "web-values"
+        values_file_path = "#{Octopus.Environment.Name}.yaml"
+      }
+    }
+  }
+}
+
+# deployment process that applies Kubernetes YAML from a git repository and a Kustomize overlay from a package
+resource "octopusdeploy_deployment_process" "kubernetes_manifests_example" {
+  project_id = "Projects-123"
+  step {
+    name         = "Apply manifests"
+    target_roles = [ "k8s" ]
+    deploy_kubernetes_yaml_action {
+      name                                   = "Apply manifests"
+      namespace                              = "web"
+      yaml_file_paths                        = [ "deploy/*.yaml" ]
+      server_side_apply_enabled              = true
+      kubernetes_object_status_check_enabled = true
+      kubernetes_object_status_timeout       = 300
+      structured_variable_substitution_files = [ "deploy/config.yaml" ]
+      git_dependency {
+        repository_uri      = "https://github.com/example/web.git"
+        default_branch      = "main"
+        git_credential_type = "Anonymous"
+      }
+    }
+  }
+  step {
+    name         = "Apply overlay"
+    target_roles = [ "k8s" ]
+    deploy_kustomize_action {
+      name         = "Apply overlay"
+      overlay_path = "overlays/#{Octopus.Environment.Name}"
+      primary_package {
+        feed_id    = "Feeds-123"
+        package_id = "web-kustomize"
+      }
+    }
+  }
+}
+
+# deployment process that deploys a CloudFormation stack and an Azure Resource Manager template
+resource "octopusdeploy_deployment_process" "cloud_templates_example" {
+  project_id = "Projects-123"
+  step {
+    name = "Deploy stack"
+    deploy_aws_cloudformation_template_action {
+      name            = "Deploy stack"
+      run_on_server   = true
+      stack_name      = "web-#{Octopus.Environment.Name}"
+      capabilities    = [ "CAPABILITY_IAM" ]
+      inline_template = file("${path.module}/stack.yaml")
+      parameters = {
+        "InstanceType" = "t3.micro"
+      }
+      tags = {
+        "team" = "web"
+      }
+      aws_account {
+        region   = "us-east-1"
+        variable = "AWS.Account"
+        role {
+          arn               = "arn:aws:iam::123456789012:role/deploy"
+          role_session_name = "octopus"
+        }
+      }
+    }
+  }
+  step {
+    name = "Deploy resource group"
+    deploy_azure_resource_group_action {
+      name                 = "Deploy resource group"
+      run_on_server        = true
+      resource_group_name  = "web-#{Octopus.Environment.Name}"
+      template_path        = "azuredeploy.json"
+      parameters_file_path = "azuredeploy.parameters.json"
+      primary_package {
+        feed_id    = "Feeds-123"
+        package_id = "web-templates"
+      }
+      azure_account {
+        variable = "Azure.Account"
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_process_child_step.<name> <project-slug>/<step-slug>/<child-step-slug>
@@ -0,0 +1,12 @@
+resource "octopusdeploy_process_child_step" "smoke_test" {
+  project_id = octopusdeploy_project.example.id
+  parent_id  = octopusdeploy_process_step.deploy_web.id
+  name       = "Smoke Test"
+  type       = "Octopus.Script"
+
+  execution_properties = {
+    "Octopus.Action.Script.ScriptSource" = "Inline"
+    "Octopus.Action.Script.Syntax"       = "PowerShell"
+    "Octopus.Action.Script.ScriptBody"   = "Invoke-WebRequest http://localhost/health"
+  }
+}
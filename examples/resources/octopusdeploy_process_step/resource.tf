@@ -0,0 +1,32 @@
+resource "octopusdeploy_process_step" "deploy_web" {
+  project_id   = octopusdeploy_project.example.id
+  name         = "Deploy Web"
+  type         = "Octopus.TentaclePackage"
+  target_roles = ["web"]
+  channels     = [octopusdeploy_channel.example.id]
+
+  primary_package = {
+    package_id = "web"
+    feed_id    = data.octopusdeploy_feeds.built_in.feeds[0].id
+  }
+
+  execution_properties = {
+    "Octopus.Action.Package.DownloadOnTentacle" = "False"
+  }
+}
+
+resource "octopusdeploy_process_step" "migrate_database" {
+  project_id = octopusdeploy_project.example.id
+  name       = "Migrate Database"
+  type       = "Octopus.Script"
+  condition  = "Success"
+
+  worker_pool_id = data.octopusdeploy_worker_pools.default.worker_pools[0].id
+
+  execution_properties = {
+    "Octopus.Action.RunOnServer"         = "true"
+    "Octopus.Action.Script.ScriptSource" = "Inline"
+    "Octopus.Action.Script.Syntax"       = "Bash"
+    "Octopus.Action.Script.ScriptBody"   = "./migrate.sh"
+  }
+}
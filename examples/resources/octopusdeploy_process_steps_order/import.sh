@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_process_steps_order.<name> <project-slug>
@@ -0,0 +1,7 @@
+resource "octopusdeploy_process_steps_order" "example" {
+  project_id = octopusdeploy_project.example.id
+  steps = [
+    octopusdeploy_process_step.migrate_database.slug,
+    octopusdeploy_process_step.deploy_web.slug,
+  ]
+}
@@ -0,0 +1,2 @@
+terraform import [options] octopusdeploy_release.<name> <release-id>
+terraform import [options] octopusdeploy_release.<name> <project-slug>:<version>
\ No newline at end of file
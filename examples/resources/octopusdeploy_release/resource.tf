@@ -0,0 +1,17 @@
+resource "octopusdeploy_release" "example" {
+  project_id    = "Projects-123"
+  version       = "1.0.0"
+  release_notes = "Initial release"
+
+  packages = [
+    {
+      step_name = "Deploy Web App"
+      version   = "1.2.3"
+    },
+    {
+      step_name              = "Run Migrations"
+      package_reference_name = "migrator"
+      version                = "4.5.6"
+    },
+  ]
+}
@@ -0,0 +1,17 @@
+resource "octopusdeploy_runbook_run" "provision_database" {
+  runbook_id      = "Runbooks-123"
+  environment_ids = ["Environments-123"]
+
+  variables = {
+    "Database Name" = "orders"
+  }
+}
+
+resource "octopusdeploy_runbook_run" "specific_snapshot" {
+  runbook_id          = "Runbooks-123"
+  snapshot_id         = "RunbookSnapshots-123"
+  environment_ids     = ["Environments-123", "Environments-456"]
+  tenant_ids          = ["Tenants-123"]
+  guided_failure_mode = "Off"
+  timeout             = "10m"
+}
@@ -0,0 +1,13 @@
+resource "octopusdeploy_runbook_snapshot" "example" {
+  runbook_id              = octopusdeploy_runbook.example.id
+  runbook_process_version = octopusdeploy_runbook_process.example.version
+  notes                   = "Published by Terraform"
+  publish                 = true
+
+  packages = [
+    {
+      step_name = "Provision Database"
+      version   = "1.2.3"
+    },
+  ]
+}
@@ -6,6 +6,15 @@ resource "octopusdeploy_tentacle_certificate" "example_with_dependencies" {
   }
 }
 
+resource "octopusdeploy_tentacle_certificate" "rotated_yearly" {
+  rotate_after = "8760h"
+}
+
+resource "octopusdeploy_tentacle_certificate" "existing" {
+  certificate_data = filebase64("tentacle.pfx")
+  password         = var.tentacle_certificate_password
+}
+
 # Usage
 resource "octopusdeploy_kubernetes_agent_deployment_target" "agent" {
   name         = "agent"
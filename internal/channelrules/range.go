@@ -0,0 +1,152 @@
+package channelrules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Range is a version range in NuGet or Maven syntax. A bare version such as 1.0 is a minimum, [1.0] is exactly 1.0,
+// [1.0,2.0) is from 1.0 up to but excluding 2.0, and (,1.0] is anything up to 1.0. Maven ranges may list several
+// intervals, such as (,1.0],[1.2,), and a version matches when it is in any of them.
+type Range struct {
+	intervals []interval
+}
+
+type interval struct {
+	min          *Version
+	minInclusive bool
+	max          *Version
+	maxInclusive bool
+}
+
+// ParseRange parses a version range. An empty range matches every version.
+func ParseRange(value string) (Range, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return Range{}, nil
+	}
+
+	if !strings.ContainsAny(trimmed[:1], "[(") {
+		minimum, err := ParseVersion(trimmed)
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid version range %q: %q is not a version or an interval such as [1.0,2.0)", value, trimmed)
+		}
+		return Range{intervals: []interval{{min: &minimum, minInclusive: true}}}, nil
+	}
+
+	var parsed Range
+	for rest := trimmed; rest != ""; {
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return Range{}, fmt.Errorf("invalid version range %q: %q is not closed with ']' or ')'", value, rest)
+		}
+
+		i, err := parseInterval(rest[:end+1])
+		if err != nil {
+			return Range{}, fmt.Errorf("invalid version range %q: %w", value, err)
+		}
+		parsed.intervals = append(parsed.intervals, i)
+
+		rest = strings.TrimSpace(rest[end+1:])
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return Range{}, fmt.Errorf("invalid version range %q: intervals must be separated by ','", value)
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if rest == "" || !strings.ContainsAny(rest[:1], "[(") {
+			return Range{}, fmt.Errorf("invalid version range %q: expected an interval after ','", value)
+		}
+	}
+
+	return parsed, nil
+}
+
+// parseInterval parses a single interval such as [1.0,2.0).
+func parseInterval(value string) (interval, error) {
+	parsed := interval{
+		minInclusive: value[0] == '[',
+		maxInclusive: value[len(value)-1] == ']',
+	}
+
+	body := value[1 : len(value)-1]
+	if strings.ContainsAny(body, "[(") {
+		return interval{}, fmt.Errorf("%q is not closed with ']' or ')'", value)
+	}
+
+	bounds := strings.Split(body, ",")
+	switch len(bounds) {
+	case 1:
+		// [1.0] is the only version 1.0.
+		if !parsed.minInclusive || !parsed.maxInclusive {
+			return interval{}, fmt.Errorf("%q must be written as [version] to match a single version", value)
+		}
+		exact, err := ParseVersion(bounds[0])
+		if err != nil {
+			return interval{}, err
+		}
+		parsed.min, parsed.max = &exact, &exact
+		return parsed, nil
+	case 2:
+	default:
+		return interval{}, fmt.Errorf("%q has more than two bounds", value)
+	}
+
+	if minimum := strings.TrimSpace(bounds[0]); minimum != "" {
+		version, err := ParseVersion(minimum)
+		if err != nil {
+			return interval{}, err
+		}
+		parsed.min = &version
+	}
+
+	if maximum := strings.TrimSpace(bounds[1]); maximum != "" {
+		version, err := ParseVersion(maximum)
+		if err != nil {
+			return interval{}, err
+		}
+		parsed.max = &version
+	}
+
+	if parsed.min != nil && parsed.max != nil {
+		c := parsed.min.Compare(*parsed.max)
+		if c > 0 || (c == 0 && !(parsed.minInclusive && parsed.maxInclusive)) {
+			return interval{}, fmt.Errorf("%q does not contain any versions", value)
+		}
+	}
+
+	return parsed, nil
+}
+
+// Contains reports whether version is in the range.
+func (r Range) Contains(version Version) bool {
+	if len(r.intervals) == 0 {
+		return true
+	}
+
+	for _, i := range r.intervals {
+		if i.contains(version) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i interval) contains(version Version) bool {
+	if i.min != nil {
+		c := version.Compare(*i.min)
+		if c < 0 || (c == 0 && !i.minInclusive) {
+			return false
+		}
+	}
+
+	if i.max != nil {
+		c := version.Compare(*i.max)
+		if c > 0 || (c == 0 && !i.maxInclusive) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,78 @@
+package channelrules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// ErrUnsupportedTag reports a tag using .NET regular expression syntax that Go does not support, such as the
+// lookaround in ^(?!beta). The Octopus Server evaluates tags with .NET regular expressions, so such tags are valid but
+// cannot be evaluated by the provider.
+var ErrUnsupportedTag = errors.New(".NET regular expression syntax cannot be evaluated by the provider")
+
+// dotNetSyntaxPattern matches the expression Go reports as invalid when a tag uses .NET-only syntax: lookaround,
+// atomic groups and conditionals, the \Z and \G anchors, backreferences and possessive quantifiers.
+var dotNetSyntaxPattern = regexp.MustCompile(`^(?:\(\?<?[=!>(]|\\[ZGk1-9]|(?:[*+?]|\{\d+(?:,\d*)?\})\+$)`)
+
+// Rule is a channel version rule. Both parts are optional: an empty range matches every version, and an empty tag
+// matches every pre-release tag.
+type Rule struct {
+	VersionRange string
+	Tag          string
+}
+
+// Validate reports a version range or tag that the Octopus Server would reject, or ErrUnsupportedTag when the tag is
+// valid but cannot be evaluated by the provider.
+func (r Rule) Validate() error {
+	if _, err := ParseRange(r.VersionRange); err != nil {
+		return err
+	}
+
+	_, err := compileTag(r.Tag)
+	return err
+}
+
+// Matches reports whether version satisfies the rule. As on the Octopus Server, the tag is matched against the
+// pre-release tag of the version, which is empty for a release, so a tag of ^$ only matches releases.
+func (r Rule) Matches(version string) (bool, error) {
+	parsedVersion, err := ParseVersion(version)
+	if err != nil {
+		return false, err
+	}
+
+	parsedRange, err := ParseRange(r.VersionRange)
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := compileTag(r.Tag)
+	if err != nil {
+		return false, err
+	}
+
+	if !parsedRange.Contains(parsedVersion) {
+		return false, nil
+	}
+
+	return tag == nil || tag.MatchString(parsedVersion.PreRelease), nil
+}
+
+func compileTag(tag string) (*regexp.Regexp, error) {
+	if strings.TrimSpace(tag) == "" {
+		return nil, nil
+	}
+
+	compiled, err := regexp.Compile(tag)
+	if err != nil {
+		var syntaxErr *syntax.Error
+		if errors.As(err, &syntaxErr) && dotNetSyntaxPattern.MatchString(syntaxErr.Expr) {
+			return nil, fmt.Errorf("invalid tag %q: %w", tag, ErrUnsupportedTag)
+		}
+		return nil, fmt.Errorf("invalid tag %q: %w", tag, err)
+	}
+
+	return compiled, nil
+}
@@ -0,0 +1,116 @@
+package channelrules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, valid := range []string{"1", "1.2", "1.2.3", "1.2.3.4", "1.0.0-beta.1", "1.0.0-beta+abc", "1.0-SNAPSHOT", "2024.3.1+12"} {
+		_, err := ParseVersion(valid)
+		require.NoError(t, err, valid)
+	}
+
+	for _, invalid := range []string{"", "v1.0", "1.2.3.4.5", "1.0-", "1.0.0-beta..1", "1.x"} {
+		_, err := ParseVersion(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1", "1.1.0.1", "2"}
+	for i := 1; i < len(ordered); i++ {
+		lower, _ := ParseVersion(ordered[i-1])
+		higher, _ := ParseVersion(ordered[i])
+		require.Equal(t, -1, lower.Compare(higher), "%s < %s", ordered[i-1], ordered[i])
+		require.Equal(t, 1, higher.Compare(lower), "%s > %s", ordered[i], ordered[i-1])
+	}
+
+	a, _ := ParseVersion("1.0+build.1")
+	b, _ := ParseVersion("1.0.0.0+build.2")
+	require.Equal(t, 0, a.Compare(b))
+}
+
+func TestParseRange(t *testing.T) {
+	for _, valid := range []string{"", "1.0", "[1.0]", "[1.0,2.0)", "(1.0,)", "(,1.0]", "[ 1.0 , 2.0 ]", "(,1.0],[1.2,)"} {
+		_, err := ParseRange(valid)
+		require.NoError(t, err, valid)
+	}
+
+	for _, invalid := range []string{"[1.0", "(1.0)", "[1.0,2.0,3.0]", "[2.0,1.0]", "(1.0,1.0]", "[1.0,2.0) [3.0,)", "[1.0,2.0),", "1.0,2.0", "[a,b]"} {
+		_, err := ParseRange(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	for _, test := range []struct {
+		rule    Rule
+		version string
+		matches bool
+	}{
+		{Rule{}, "1.0.0-anything", true},
+		{Rule{VersionRange: "1.0"}, "0.9", false},
+		{Rule{VersionRange: "1.0"}, "1.0", true},
+		{Rule{VersionRange: "1.0"}, "5.0", true},
+		{Rule{VersionRange: "[1.0]"}, "1.0.0", true},
+		{Rule{VersionRange: "[1.0]"}, "1.0.1", false},
+		{Rule{VersionRange: "[1.0,2.0)"}, "1.9.9", true},
+		{Rule{VersionRange: "[1.0,2.0)"}, "2.0", false},
+		{Rule{VersionRange: "[1.0,2.0)"}, "2.0.0-beta", true},
+		{Rule{VersionRange: "(,1.0]"}, "1.0", true},
+		{Rule{VersionRange: "(,1.0],[1.2,)"}, "1.1", false},
+		{Rule{VersionRange: "(,1.0],[1.2,)"}, "1.3", true},
+		{Rule{Tag: "^$"}, "1.0.0", true},
+		{Rule{Tag: "^$"}, "1.0.0-beta", false},
+		{Rule{Tag: ".+"}, "1.0.0", false},
+		{Rule{Tag: "^beta"}, "1.0.0-beta.1", true},
+		{Rule{Tag: "^beta"}, "1.0.0-rc.1", false},
+		{Rule{VersionRange: "[2.0,3.0)", Tag: "^$"}, "2.1.0+build.5", true},
+	} {
+		matches, err := test.rule.Matches(test.version)
+		require.NoError(t, err)
+		require.Equal(t, test.matches, matches, "%+v with %s", test.rule, test.version)
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	require.NoError(t, Rule{VersionRange: "[1.0,2.0)", Tag: "^$"}.Validate())
+	require.Error(t, Rule{VersionRange: "[1.0,2.0"}.Validate())
+	require.Error(t, Rule{Tag: "beta("}.Validate())
+
+	err := Rule{Tag: "^(?!beta).+"}.Validate()
+	require.True(t, errors.Is(err, ErrUnsupportedTag))
+}
+
+func TestRuleValidateClassifiesDotNetSyntax(t *testing.T) {
+	for _, test := range []struct {
+		tag         string
+		unsupported bool
+	}{
+		{`^(?!beta).+`, true},
+		{`^(?=beta)`, true},
+		{`(?<=a)b`, true},
+		{`(?<!a)b`, true},
+		{`^(?>beta)`, true},
+		{`^(?(beta)beta|rc)`, true},
+		{`^(beta|rc)\d+\Z`, true},
+		{`\Gbeta`, true},
+		{`(a)\1`, true},
+		{`(?<name>a)\k<name>`, true},
+		{`^beta\d++`, true},
+		{`^beta\d*+`, true},
+		{`^beta\d{2}+`, true},
+		{`^beta\d{2,}+`, true},
+		{`beta(`, false},
+		{`[z-a]`, false},
+		{`a**`, false},
+		{`(?z)`, false},
+	} {
+		err := Rule{Tag: test.tag}.Validate()
+		require.Error(t, err, test.tag)
+		require.Equal(t, test.unsupported, errors.Is(err, ErrUnsupportedTag), test.tag)
+	}
+}
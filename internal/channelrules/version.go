@@ -0,0 +1,100 @@
+// Package channelrules evaluates the version rules of Octopus Deploy channels, which restrict the package versions a
+// release in the channel may use with a version range and a regular expression matched against the pre-release tag.
+package channelrules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var versionPattern = regexp.MustCompile(`^(\d+(?:\.\d+){0,3})(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// Version is a package version with up to four numeric parts, an optional pre-release tag and optional build metadata,
+// such as 1.2.3-beta.1+abc.
+type Version struct {
+	parts      [4]int
+	PreRelease string
+	Metadata   string
+}
+
+// ParseVersion parses a package version.
+func ParseVersion(value string) (Version, error) {
+	matches := versionPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return Version{}, fmt.Errorf("%q is not a valid version", value)
+	}
+
+	version := Version{PreRelease: matches[2], Metadata: matches[3]}
+	for i, part := range strings.Split(matches[1], ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return Version{}, fmt.Errorf("%q is not a valid version", value)
+		}
+		version.parts[i] = number
+	}
+
+	return version, nil
+}
+
+// Compare returns -1, 0 or 1 as v is lower than, equal to or higher than other. A release is higher than any of its
+// pre-releases, and build metadata is ignored.
+func (v Version) Compare(other Version) int {
+	for i := range v.parts {
+		if c := compareInts(v.parts[i], other.parts[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case v.PreRelease == other.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case other.PreRelease == "":
+		return -1
+	}
+
+	return comparePreReleases(v.PreRelease, other.PreRelease)
+}
+
+// comparePreReleases compares pre-release tags identifier by identifier: numeric identifiers numerically and below
+// alphanumeric ones, which compare case-insensitively.
+func comparePreReleases(a string, b string) int {
+	left := strings.Split(a, ".")
+	right := strings.Split(b, ".")
+
+	for i := 0; i < len(left) && i < len(right); i++ {
+		leftNumber, leftErr := strconv.Atoi(left[i])
+		rightNumber, rightErr := strconv.Atoi(right[i])
+
+		var c int
+		switch {
+		case leftErr == nil && rightErr == nil:
+			c = compareInts(leftNumber, rightNumber)
+		case leftErr == nil:
+			c = -1
+		case rightErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(strings.ToLower(left[i]), strings.ToLower(right[i]))
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(left), len(right))
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
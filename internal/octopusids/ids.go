@@ -0,0 +1,98 @@
+// Package octopusids builds and parses the identifiers the Octopus Server uses for its resources: IDs, slugs and
+// canonical tag names.
+package octopusids
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var idPattern = regexp.MustCompile(`^(.+)-(\d+)$`)
+
+var spaceIDPattern = regexp.MustCompile(`^Spaces-\d+$`)
+
+// Slugify returns the slug the Octopus Server generates for name: lowercase letters and digits, with each run of other
+// characters replaced by a single hyphen. Accents are removed and apostrophes dropped, so "Café d'Été" becomes
+// "cafe-dete".
+func Slugify(name string) string {
+	var slug strings.Builder
+	pendingHyphen := false
+
+	for _, r := range norm.NFKD.String(strings.ToLower(name)) {
+		switch {
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+			continue
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if pendingHyphen && slug.Len() > 0 {
+				slug.WriteRune('-')
+			}
+			pendingHyphen = false
+			slug.WriteRune(r)
+		default:
+			pendingHyphen = true
+		}
+	}
+
+	return slug.String()
+}
+
+// ID is an Octopus resource ID split into its type and number, such as "Projects" and 123 for "Projects-123".
+type ID struct {
+	Type   string
+	Number int64
+}
+
+// ParseID splits id into its type and number.
+func ParseID(id string) (ID, error) {
+	matches := idPattern.FindStringSubmatch(id)
+	if matches == nil {
+		return ID{}, fmt.Errorf("%q is not an Octopus ID; IDs are a type and a number separated by a hyphen, such as Projects-123", id)
+	}
+
+	number, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return ID{}, fmt.Errorf("%q is not an Octopus ID: %w", id, err)
+	}
+
+	return ID{Type: matches[1], Number: number}, nil
+}
+
+// SpaceQualifiedID returns id prefixed with the ID of its space, such as "Spaces-1/Projects-123", which identifies a
+// resource unambiguously across spaces.
+func SpaceQualifiedID(spaceID string, id string) (string, error) {
+	if !spaceIDPattern.MatchString(spaceID) {
+		return "", fmt.Errorf("%q is not a space ID; space IDs look like Spaces-1", spaceID)
+	}
+	if id == "" {
+		return "", fmt.Errorf("the ID to qualify must not be empty")
+	}
+
+	if prefix, unqualified, found := strings.Cut(id, "/"); found {
+		if prefix != spaceID {
+			return "", fmt.Errorf("%q is already qualified with a different space than %s", id, spaceID)
+		}
+		id = unqualified
+	}
+
+	return spaceID + "/" + id, nil
+}
+
+// TenantTag returns the canonical name of a tag, such as "Regions/Europe", which is how tenant tags are referenced.
+func TenantTag(tagSet string, tag string) (string, error) {
+	tagSet = strings.TrimSpace(tagSet)
+	tag = strings.TrimSpace(tag)
+
+	if tagSet == "" || tag == "" {
+		return "", fmt.Errorf("both the tag set name and the tag name are required")
+	}
+	if strings.Contains(tagSet, "/") {
+		return "", fmt.Errorf("the tag set name %q must not contain '/'", tagSet)
+	}
+
+	return tagSet + "/" + tag, nil
+}
@@ -0,0 +1,67 @@
+package octopusids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	for name, slug := range map[string]string{
+		"Default Project Group": "default-project-group",
+		"Dev & Test":            "dev-test",
+		"  Leading/trailing  ":  "leading-trailing",
+		"Café d'Été":            "cafe-dete",
+		"Web_App v2.0":          "web-app-v2-0",
+		"already-a-slug":        "already-a-slug",
+		"---":                   "",
+	} {
+		require.Equal(t, slug, Slugify(name), name)
+	}
+}
+
+func TestParseID(t *testing.T) {
+	id, err := ParseID("Projects-123")
+	require.NoError(t, err)
+	require.Equal(t, ID{Type: "Projects", Number: 123}, id)
+
+	id, err = ParseID("variableset-Projects-42")
+	require.NoError(t, err)
+	require.Equal(t, ID{Type: "variableset-Projects", Number: 42}, id)
+
+	for _, invalid := range []string{"", "Projects", "Projects-", "-123", "Projects-12a"} {
+		_, err := ParseID(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSpaceQualifiedID(t *testing.T) {
+	id, err := SpaceQualifiedID("Spaces-1", "Projects-123")
+	require.NoError(t, err)
+	require.Equal(t, "Spaces-1/Projects-123", id)
+
+	id, err = SpaceQualifiedID("Spaces-1", "Spaces-1/Projects-123")
+	require.NoError(t, err)
+	require.Equal(t, "Spaces-1/Projects-123", id)
+
+	_, err = SpaceQualifiedID("Spaces-2", "Spaces-1/Projects-123")
+	require.Error(t, err)
+
+	_, err = SpaceQualifiedID("Default", "Projects-123")
+	require.Error(t, err)
+
+	_, err = SpaceQualifiedID("Spaces-1", "")
+	require.Error(t, err)
+}
+
+func TestTenantTag(t *testing.T) {
+	tag, err := TenantTag("Regions", "Europe")
+	require.NoError(t, err)
+	require.Equal(t, "Regions/Europe", tag)
+
+	_, err = TenantTag("", "Europe")
+	require.Error(t, err)
+
+	_, err = TenantTag("Regions/Continents", "Europe")
+	require.Error(t, err)
+}
@@ -0,0 +1,458 @@
+package octostache
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxDepth bounds how deeply variables may reference other variables, so that cycles end.
+const maxDepth = 32
+
+// Evaluate parses template and evaluates it against variables. Variable names are matched case-insensitively, and the
+// values of variables are themselves evaluated. As with the Octopus Server, an expression that cannot be resolved is
+// left in the output as written.
+func Evaluate(template string, variables map[string]string) (string, error) {
+	parsed, err := Parse(template)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.Evaluate(variables), nil
+}
+
+// Evaluate evaluates the template against variables.
+func (t *Template) Evaluate(variables map[string]string) string {
+	e := newEvaluator(variables)
+
+	var output strings.Builder
+	e.render(&output, t.nodes, nil)
+	return output.String()
+}
+
+type evaluator struct {
+	values    map[string]string
+	names     []string
+	resolving map[string]bool
+}
+
+// scope binds the iterator of an #{each} block to the current item.
+type scope struct {
+	parent   *scope
+	iterator string
+	item     item
+	index    int
+	count    int
+}
+
+// item is an element of a collection iterated by #{each}. Items of indexed variables, such as Servers[Web].Port, are
+// qualified by their prefix; items of JSON arrays and objects carry their JSON value.
+type item struct {
+	value  string
+	prefix string
+	json   interface{}
+}
+
+func newEvaluator(variables map[string]string) *evaluator {
+	e := &evaluator{
+		values:    make(map[string]string, len(variables)),
+		resolving: map[string]bool{},
+	}
+
+	for name, value := range variables {
+		e.values[strings.ToLower(name)] = value
+		e.names = append(e.names, name)
+	}
+	sort.Strings(e.names)
+
+	return e
+}
+
+func (e *evaluator) render(output *strings.Builder, nodes []node, s *scope) {
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *textNode:
+			output.WriteString(n.text)
+		case *substitutionNode:
+			if value, ok := e.evaluateExpression(n.expression, s); ok {
+				output.WriteString(value)
+			} else {
+				output.WriteString(n.raw)
+			}
+		case *conditionalNode:
+			if e.evaluateCondition(n.condition, s) != n.negate {
+				e.render(output, n.then, s)
+			} else {
+				e.render(output, n.otherwise, s)
+			}
+		case *eachNode:
+			items := e.collection(n.collection, s)
+			for i, current := range items {
+				e.render(output, n.body, &scope{parent: s, iterator: n.iterator, item: current, index: i, count: len(items)})
+			}
+		}
+	}
+}
+
+func (e *evaluator) evaluateExpression(expr expression, s *scope) (string, bool) {
+	value := ""
+	if expr.symbol != "" {
+		resolved, ok := e.resolve(expr.symbol, s)
+		if !ok {
+			return "", false
+		}
+		value = resolved
+	}
+
+	for _, call := range expr.filters {
+		filtered, ok := applyFilter(call, value)
+		if !ok {
+			return "", false
+		}
+		value = filtered
+	}
+
+	return value, true
+}
+
+func (e *evaluator) evaluateCondition(c condition, s *scope) bool {
+	left, ok := e.evaluateExpression(c.left, s)
+
+	switch c.operator {
+	case "":
+		return ok && isTruthy(left)
+	}
+
+	right := c.literal
+	if c.right != nil {
+		right, _ = e.evaluateExpression(*c.right, s)
+	}
+
+	if c.operator == "==" {
+		return ok && left == right
+	}
+	return !ok || left != right
+}
+
+// isTruthy reports whether a value satisfies #{if}: anything other than an empty string, "0", "no" or "false".
+func isTruthy(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "no", "false":
+		return false
+	}
+	return true
+}
+
+// resolve returns the value of the variable named by symbol, after evaluating any expressions inside its index
+// brackets.
+func (e *evaluator) resolve(symbol string, s *scope) (string, bool) {
+	name, ok := e.expandSymbol(symbol, s)
+	if !ok {
+		return "", false
+	}
+
+	for current := s; current != nil; current = current.parent {
+		if value, ok := current.special(name); ok {
+			return value, true
+		}
+
+		if strings.EqualFold(name, current.iterator) {
+			return current.item.value, true
+		}
+
+		if rest, ok := cutIterator(name, current.iterator); ok {
+			if current.item.json != nil {
+				return navigateJSON(current.item.json, rest)
+			}
+			if current.item.prefix != "" {
+				name = current.item.prefix + rest
+			}
+		}
+	}
+
+	return e.lookup(name)
+}
+
+func (e *evaluator) lookup(name string) (string, bool) {
+	if value, ok := e.variable(name); ok {
+		return value, true
+	}
+
+	// Fall back to a JSON variable holding the value, such as Config holding {"Port": 80} for Config.Port.
+	for i := len(name) - 1; i > 0; i-- {
+		if name[i] != '.' && name[i] != '[' {
+			continue
+		}
+
+		value, ok := e.variable(name[:i])
+		if !ok {
+			continue
+		}
+
+		document, ok := parseJSON(value)
+		if !ok {
+			continue
+		}
+
+		return navigateJSON(document, name[i:])
+	}
+
+	return "", false
+}
+
+// variable returns the value of the variable called name, evaluating the expressions it contains.
+func (e *evaluator) variable(name string) (string, bool) {
+	key := strings.ToLower(name)
+	value, ok := e.values[key]
+	if !ok {
+		return "", false
+	}
+
+	if !strings.Contains(value, "#{") {
+		return value, true
+	}
+	if e.resolving[key] || len(e.resolving) >= maxDepth {
+		return "", false
+	}
+
+	parsed, err := Parse(value)
+	if err != nil {
+		return value, true
+	}
+
+	e.resolving[key] = true
+	defer delete(e.resolving, key)
+
+	var output strings.Builder
+	e.render(&output, parsed.nodes, nil)
+	return output.String(), true
+}
+
+// expandSymbol evaluates the expressions nested in a variable name, such as Octopus.Action[#{StepName}].Output.
+func (e *evaluator) expandSymbol(symbol string, s *scope) (string, bool) {
+	if !strings.Contains(symbol, "#{") {
+		return symbol, true
+	}
+
+	parsed, err := Parse(symbol)
+	if err != nil {
+		return "", false
+	}
+
+	var output strings.Builder
+	e.render(&output, parsed.nodes, s)
+	expanded := output.String()
+
+	return expanded, !strings.Contains(expanded, "#{")
+}
+
+func (s *scope) special(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "octopus.template.each.first":
+		return strconv.FormatBool(s.index == 0), true
+	case "octopus.template.each.last":
+		return strconv.FormatBool(s.index == s.count-1), true
+	case "octopus.template.each.index":
+		return strconv.Itoa(s.index), true
+	}
+	return "", false
+}
+
+// cutIterator returns the part of name after the iterator, such as ".Port" for item.Port.
+func cutIterator(name string, iterator string) (string, bool) {
+	if len(name) <= len(iterator) || !strings.EqualFold(name[:len(iterator)], iterator) {
+		return "", false
+	}
+
+	rest := name[len(iterator):]
+	if rest[0] != '.' && rest[0] != '[' {
+		return "", false
+	}
+	return rest, true
+}
+
+// collection returns the items #{each} iterates: the elements of a JSON array or object, the comma-separated values of
+// a variable, or the distinct indexes of indexed variables such as Servers[Web].Port.
+func (e *evaluator) collection(symbol string, s *scope) []item {
+	name, ok := e.expandSymbol(symbol, s)
+	if !ok {
+		return nil
+	}
+
+	for current := s; current != nil; current = current.parent {
+		if rest, ok := cutIterator(name, current.iterator); ok {
+			if current.item.json != nil {
+				return jsonItems(current.item.json, rest)
+			}
+			if current.item.prefix != "" {
+				name = current.item.prefix + rest
+			}
+		}
+	}
+
+	if value, ok := e.lookup(name); ok {
+		if document, ok := parseJSON(value); ok {
+			return jsonItems(document, "")
+		}
+
+		var items []item
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				items = append(items, item{value: part})
+			}
+		}
+		return items
+	}
+
+	var items []item
+	seen := map[string]bool{}
+	prefix := strings.ToLower(name) + "["
+	for _, variable := range e.names {
+		if !strings.HasPrefix(strings.ToLower(variable), prefix) {
+			continue
+		}
+
+		end := strings.Index(variable[len(prefix):], "]")
+		if end < 0 {
+			continue
+		}
+
+		index := variable[len(prefix) : len(prefix)+end]
+		if seen[strings.ToLower(index)] {
+			continue
+		}
+		seen[strings.ToLower(index)] = true
+
+		items = append(items, item{value: index, prefix: name + "[" + index + "]"})
+	}
+
+	return items
+}
+
+func parseJSON(value string) (interface{}, bool) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+	return document, true
+}
+
+func jsonItems(document interface{}, path string) []item {
+	if path != "" {
+		value, ok := navigate(document, path)
+		if !ok {
+			return nil
+		}
+		document = value
+	}
+
+	var items []item
+	switch value := document.(type) {
+	case []interface{}:
+		for _, element := range value {
+			items = append(items, item{value: formatJSON(element), json: element})
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			items = append(items, item{value: key, json: map[string]interface{}{"Key": key, "Value": value[key]}})
+		}
+	}
+	return items
+}
+
+func navigateJSON(document interface{}, path string) (string, bool) {
+	value, ok := navigate(document, path)
+	if !ok {
+		return "", false
+	}
+	return formatJSON(value), true
+}
+
+// navigate follows a path such as .Servers[0].Name through a JSON document. Property names are matched
+// case-insensitively.
+func navigate(document interface{}, path string) (interface{}, bool) {
+	for path != "" {
+		var segment string
+		switch path[0] {
+		case '.':
+			end := strings.IndexAny(path[1:], ".[")
+			if end < 0 {
+				segment, path = path[1:], ""
+			} else {
+				segment, path = path[1:end+1], path[end+1:]
+			}
+		case '[':
+			end := strings.Index(path, "]")
+			if end < 0 {
+				return nil, false
+			}
+			segment, path = path[1:end], path[end+1:]
+		default:
+			return nil, false
+		}
+
+		switch value := document.(type) {
+		case map[string]interface{}:
+			next, ok := value[segment]
+			if !ok {
+				for key, candidate := range value {
+					if strings.EqualFold(key, segment) {
+						next, ok = candidate, true
+						break
+					}
+				}
+			}
+			if !ok {
+				return nil, false
+			}
+			document = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			document = value[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return document, true
+}
+
+func formatJSON(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
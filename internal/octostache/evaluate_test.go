@@ -0,0 +1,118 @@
+package octostache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func evaluate(t *testing.T, template string, variables map[string]string) string {
+	result, err := Evaluate(template, variables)
+	require.NoError(t, err, template)
+	return result
+}
+
+func TestEvaluateSubstitution(t *testing.T) {
+	variables := map[string]string{
+		"Name":                              "web",
+		"Greeting":                          "Hello #{Name}",
+		"Octopus.Action[Deploy Web].Output": "ok",
+		"StepName":                          "Deploy Web",
+		"Loop":                              "#{Loop}",
+	}
+
+	require.Equal(t, "web", evaluate(t, "#{Name}", variables))
+	require.Equal(t, "web", evaluate(t, "#{name}", variables))
+	require.Equal(t, "Hello web!", evaluate(t, "#{Greeting}!", variables))
+	require.Equal(t, "ok", evaluate(t, "#{Octopus.Action[#{StepName}].Output}", variables))
+	require.Equal(t, "#{Missing}", evaluate(t, "#{Missing}", variables))
+	require.Equal(t, "#{Loop}", evaluate(t, "#{Loop}", variables))
+	require.Equal(t, "#{Name}", evaluate(t, "##{Name}", variables))
+}
+
+func TestEvaluateJSON(t *testing.T) {
+	variables := map[string]string{
+		"Config": `{"Port": 8080, "Hosts": ["a", "b"], "Database": {"Name": "app"}}`,
+	}
+
+	require.Equal(t, "8080", evaluate(t, "#{Config.Port}", variables))
+	require.Equal(t, "b", evaluate(t, "#{Config.Hosts[1]}", variables))
+	require.Equal(t, "app", evaluate(t, "#{Config.database.name}", variables))
+	require.Equal(t, `{"Name":"app"}`, evaluate(t, "#{Config.Database}", variables))
+	require.Equal(t, "#{Config.Missing}", evaluate(t, "#{Config.Missing}", variables))
+}
+
+func TestEvaluateConditionals(t *testing.T) {
+	variables := map[string]string{
+		"Enabled":     "True",
+		"Disabled":    "False",
+		"Environment": "Production",
+		"Other":       "Production",
+	}
+
+	require.Equal(t, "on", evaluate(t, "#{if Enabled}on#{else}off#{/if}", variables))
+	require.Equal(t, "off", evaluate(t, "#{if Disabled}on#{else}off#{/if}", variables))
+	require.Equal(t, "off", evaluate(t, "#{if Missing}on#{else}off#{/if}", variables))
+	require.Equal(t, "off", evaluate(t, "#{unless Enabled}on#{else}off#{/unless}", variables))
+	require.Equal(t, "prod", evaluate(t, "#{if Environment == \"Production\"}prod#{/if}", variables))
+	require.Equal(t, "", evaluate(t, "#{if Environment != Other}different#{/if}", variables))
+	require.Equal(t, "yes", evaluate(t, "#{if Environment | StartsWith Prod}yes#{/if}", variables))
+}
+
+func TestEvaluateEach(t *testing.T) {
+	variables := map[string]string{
+		"Regions":           "us-east, eu-west",
+		"Servers[Web].Port": "80",
+		"Servers[Api].Port": "8080",
+		"Servers[Web].Host": "web.local",
+		"Config":            `{"Items": [{"Name": "a"}, {"Name": "b"}]}`,
+		"Ports":             `{"http": 80, "https": 443}`,
+	}
+
+	require.Equal(t, "us-east;eu-west;", evaluate(t, "#{each r in Regions}#{r};#{/each}", variables))
+	require.Equal(t, "Api=8080 Web=80 ", evaluate(t, "#{each s in Servers}#{s}=#{s.Port} #{/each}", variables))
+	require.Equal(t, "a,b", evaluate(t, "#{each i in Config.Items}#{i.Name}#{unless Octopus.Template.Each.Last},#{/unless}#{/each}", variables))
+	require.Equal(t, "http:80 https:443 ", evaluate(t, "#{each p in Ports}#{p.Key}:#{p.Value} #{/each}", variables))
+	require.Equal(t, "0us-east 1eu-west ", evaluate(t, "#{each r in Regions}#{Octopus.Template.Each.Index}#{r} #{/each}", variables))
+}
+
+func TestEvaluateFilters(t *testing.T) {
+	variables := map[string]string{
+		"Name":   "  My App  ",
+		"Text":   `a "quoted" <tag>`,
+		"Host":   "web-01.example.com",
+		"Secret": "aGVsbG8=",
+	}
+
+	for template, expected := range map[string]string{
+		"#{Name | Trim | ToUpper}":               "MY APP",
+		"#{Name | Trim | ToLower}":               "my app",
+		"#{Host | Truncate 6}":                   "web-01...",
+		"#{Host | Substring 3}":                  "web",
+		"#{Host | Substring 4 2}":                "01",
+		"#{Host | Replace \"\\.example\\.com\"}": "web-01",
+		"#{Host | Replace \"-(\\d+)\" \"_$1\"}":  "web_01.example.com",
+		"#{Text | HtmlEscape}":                   "a &#34;quoted&#34; &lt;tag&gt;",
+		"#{Text | XmlEscape}":                    "a &quot;quoted&quot; &lt;tag&gt;",
+		"#{Text | JsonEscape}":                   `a \"quoted\" <tag>`,
+		"#{Name | Trim | UriEscape}":             "My%20App",
+		"#{Text | YamlDoubleQuoteEscape}":        `a \"quoted\" <tag>`,
+		"#{Secret | FromBase64}":                 "hello",
+		"#{Secret | FromBase64 | ToBase64}":      "aGVsbG8=",
+		"#{Host | Match \"^web-\\d+\"}":          "true",
+		"#{Host | EndsWith .com}":                "true",
+		"#{Host | Contains api}":                 "false",
+		"#{Secret | FromBase64 | MD5}":           "5d41402abc4b2a76b9719d911017c592",
+		"#{Secret | FromBase64 | SHA256}":        "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		"#{Host | NoSuchFilter}":                 "#{Host | NoSuchFilter}",
+		"#{Host | Truncate many}":                "#{Host | Truncate many}",
+		"#{ | ToUpper}":                          "",
+	} {
+		require.Equal(t, expected, evaluate(t, template, variables), template)
+	}
+}
+
+func TestEvaluateReportsSyntaxErrors(t *testing.T) {
+	_, err := Evaluate("#{if Enabled}on", nil)
+	require.Error(t, err)
+}
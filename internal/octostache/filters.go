@@ -0,0 +1,231 @@
+package octostache
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// filter transforms a value, reporting false when its arguments are not valid.
+type filter func(value string, arguments []string) (string, bool)
+
+// filters are the built-in Octostache filters, keyed by lowercase name.
+var filters = map[string]filter{
+	"toupper":               noArguments(strings.ToUpper),
+	"tolower":               noArguments(strings.ToLower),
+	"trim":                  noArguments(strings.TrimSpace),
+	"truncate":              truncateFilter,
+	"substring":             substringFilter,
+	"replace":               replaceFilter,
+	"htmlescape":            noArguments(html.EscapeString),
+	"xmlescape":             noArguments(xmlEscape),
+	"jsonescape":            noArguments(jsonEscape),
+	"uriescape":             noArguments(url.PathEscape),
+	"uridataescape":         noArguments(url.QueryEscape),
+	"yamlsinglequoteescape": noArguments(yamlSingleQuoteEscape),
+	"yamldoublequoteescape": noArguments(yamlDoubleQuoteEscape),
+	"propertieskeyescape":   noArguments(propertiesKeyEscape),
+	"propertiesvalueescape": noArguments(propertiesValueEscape),
+	"tobase64":              noArguments(toBase64),
+	"frombase64":            fromBase64,
+	"match":                 matchFilter,
+	"startswith":            comparisonFilter(strings.HasPrefix),
+	"endswith":              comparisonFilter(strings.HasSuffix),
+	"contains":              comparisonFilter(strings.Contains),
+	"md5":                   hashFilter(md5.New),
+	"sha1":                  hashFilter(sha1.New),
+	"sha256":                hashFilter(sha256.New),
+	"sha384":                hashFilter(sha512.New384),
+	"sha512":                hashFilter(sha512.New),
+}
+
+// applyFilter applies call to value. An unknown filter, or one given invalid arguments, leaves the expression
+// unresolved.
+func applyFilter(call filterCall, value string) (string, bool) {
+	f, ok := filters[strings.ToLower(call.name)]
+	if !ok {
+		return "", false
+	}
+	return f(value, call.arguments)
+}
+
+func noArguments(transform func(string) string) filter {
+	return func(value string, arguments []string) (string, bool) {
+		return transform(value), true
+	}
+}
+
+func truncateFilter(value string, arguments []string) (string, bool) {
+	if len(arguments) != 1 {
+		return "", false
+	}
+
+	length, err := strconv.Atoi(arguments[0])
+	if err != nil || length < 0 {
+		return "", false
+	}
+
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value, true
+	}
+	return string(runes[:length]) + "...", true
+}
+
+func substringFilter(value string, arguments []string) (string, bool) {
+	if len(arguments) < 1 || len(arguments) > 2 {
+		return "", false
+	}
+
+	runes := []rune(value)
+	numbers := make([]int, len(arguments))
+	for i, argument := range arguments {
+		number, err := strconv.Atoi(argument)
+		if err != nil || number < 0 {
+			return "", false
+		}
+		numbers[i] = number
+	}
+
+	// With one argument, Substring takes the first n characters; with two, n characters from an offset.
+	start, length := 0, numbers[0]
+	if len(numbers) == 2 {
+		start, length = numbers[0], numbers[1]
+	}
+
+	if start > len(runes) {
+		return "", true
+	}
+	if start+length > len(runes) {
+		length = len(runes) - start
+	}
+	return string(runes[start : start+length]), true
+}
+
+func replaceFilter(value string, arguments []string) (string, bool) {
+	if len(arguments) < 1 || len(arguments) > 2 {
+		return "", false
+	}
+
+	pattern, err := regexp.Compile(arguments[0])
+	if err != nil {
+		return "", false
+	}
+
+	replacement := ""
+	if len(arguments) == 2 {
+		replacement = arguments[1]
+	}
+	return pattern.ReplaceAllString(value, replacement), true
+}
+
+func matchFilter(value string, arguments []string) (string, bool) {
+	if len(arguments) != 1 {
+		return "", false
+	}
+
+	pattern, err := regexp.Compile(arguments[0])
+	if err != nil {
+		return "", false
+	}
+	return strconv.FormatBool(pattern.MatchString(value)), true
+}
+
+func comparisonFilter(compare func(string, string) bool) filter {
+	return func(value string, arguments []string) (string, bool) {
+		if len(arguments) != 1 {
+			return "", false
+		}
+		return strconv.FormatBool(compare(value, arguments[0])), true
+	}
+}
+
+func hashFilter(newHash func() hash.Hash) filter {
+	return func(value string, arguments []string) (string, bool) {
+		h := newHash()
+		h.Write([]byte(value))
+		return hex.EncodeToString(h.Sum(nil)), true
+	}
+}
+
+func xmlEscape(value string) string {
+	return strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&quot;",
+		"'", "&apos;",
+	).Replace(value)
+}
+
+func jsonEscape(value string) string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return value
+	}
+
+	encoded := strings.TrimSuffix(buffer.String(), "\n")
+	return encoded[1 : len(encoded)-1]
+}
+
+func yamlSingleQuoteEscape(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+func yamlDoubleQuoteEscape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(value)
+}
+
+func propertiesKeyEscape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		" ", `\ `,
+		"=", `\=`,
+		":", `\:`,
+		"#", `\#`,
+		"!", `\!`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(value)
+}
+
+func propertiesValueEscape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(value)
+}
+
+func toBase64(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func fromBase64(value string, arguments []string) (string, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return "", false
+	}
+	return string(decoded), true
+}
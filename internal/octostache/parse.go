@@ -0,0 +1,441 @@
+// Package octostache parses and evaluates Octostache, the template syntax of Octopus Deploy variables. It supports
+// substitution with filters, #{if}, #{unless}, #{else} and #{each} blocks, indexed and JSON variables, and the ## escape.
+package octostache
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var filterNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+var eachPattern = regexp.MustCompile(`^each\s+([A-Za-z_][A-Za-z0-9_]*)\s+in\s+(.+)$`)
+
+// Template is a parsed Octostache template.
+type Template struct {
+	nodes []node
+}
+
+type node interface{}
+
+type textNode struct {
+	text string
+}
+
+type substitutionNode struct {
+	raw        string
+	expression expression
+}
+
+type conditionalNode struct {
+	raw       string
+	condition condition
+	negate    bool
+	then      []node
+	otherwise []node
+}
+
+type eachNode struct {
+	raw        string
+	iterator   string
+	collection string
+	body       []node
+}
+
+// expression is a symbol with the filters applied to its value.
+type expression struct {
+	symbol  string
+	filters []filterCall
+}
+
+type filterCall struct {
+	name      string
+	arguments []string
+}
+
+// condition is an expression on its own, or compared with a literal or another expression.
+type condition struct {
+	left     expression
+	operator string
+	right    *expression
+	literal  string
+}
+
+// SyntaxError describes an expression that cannot be parsed, or a block that is not closed.
+type SyntaxError struct {
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s (at offset %d)", e.Message, e.Offset)
+}
+
+// Parse parses template, reporting unterminated expressions, unbalanced blocks and malformed filters.
+func Parse(template string) (*Template, error) {
+	root := &blockFrame{}
+	stack := []*blockFrame{root}
+
+	for offset := 0; offset < len(template); {
+		start := strings.Index(template[offset:], "#{")
+		if start < 0 {
+			stack[len(stack)-1].append(&textNode{text: template[offset:]})
+			break
+		}
+		start += offset
+
+		if start > offset && template[start-1] == '#' {
+			// ##{ is an escaped #{, written out as is.
+			stack[len(stack)-1].append(&textNode{text: template[offset:start-1] + "#{"})
+			offset = start + 2
+			continue
+		}
+		if start > offset {
+			stack[len(stack)-1].append(&textNode{text: template[offset:start]})
+		}
+
+		end, err := findExpressionEnd(template, start)
+		if err != nil {
+			return nil, err
+		}
+
+		raw := template[start : end+1]
+		content := strings.TrimSpace(template[start+2 : end])
+		offset = end + 1
+
+		var parseErr error
+		stack, parseErr = parseToken(stack, raw, content, start)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+	}
+
+	if len(stack) > 1 {
+		open := stack[len(stack)-1]
+		return nil, &SyntaxError{Offset: open.offset, Message: fmt.Sprintf("%s is not closed with #{/%s}", open.raw, open.kind)}
+	}
+
+	return &Template{nodes: root.nodes}, nil
+}
+
+// Validate reports whether template can be parsed.
+func Validate(template string) error {
+	_, err := Parse(template)
+	return err
+}
+
+type blockFrame struct {
+	kind   string
+	raw    string
+	offset int
+
+	nodes       []node
+	conditional *conditionalNode
+	each        *eachNode
+	inElse      bool
+}
+
+func (f *blockFrame) append(n node) {
+	switch {
+	case f.conditional != nil && f.inElse:
+		f.conditional.otherwise = append(f.conditional.otherwise, n)
+	case f.conditional != nil:
+		f.conditional.then = append(f.conditional.then, n)
+	case f.each != nil:
+		f.each.body = append(f.each.body, n)
+	default:
+		f.nodes = append(f.nodes, n)
+	}
+}
+
+func parseToken(stack []*blockFrame, raw string, content string, offset int) ([]*blockFrame, error) {
+	current := stack[len(stack)-1]
+	keyword, rest := splitKeyword(content)
+
+	switch keyword {
+	case "if", "unless":
+		if rest == "" {
+			return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s has no condition", raw)}
+		}
+		parsed, err := parseCondition(rest, offset)
+		if err != nil {
+			return nil, err
+		}
+		conditional := &conditionalNode{raw: raw, condition: parsed, negate: keyword == "unless"}
+		current.append(conditional)
+		return append(stack, &blockFrame{kind: keyword, raw: raw, offset: offset, conditional: conditional}), nil
+
+	case "else":
+		if rest != "" {
+			break
+		}
+		if current.conditional == nil || current.inElse {
+			return nil, &SyntaxError{Offset: offset, Message: "#{else} is not inside an #{if} or #{unless} block"}
+		}
+		current.inElse = true
+		return stack, nil
+
+	case "each":
+		matches := eachPattern.FindStringSubmatch(content)
+		if matches == nil {
+			return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s must be in the form #{each item in Collection}", raw)}
+		}
+		if err := checkSymbol(matches[2], offset); err != nil {
+			return nil, err
+		}
+		each := &eachNode{raw: raw, iterator: matches[1], collection: strings.TrimSpace(matches[2])}
+		current.append(each)
+		return append(stack, &blockFrame{kind: "each", raw: raw, offset: offset, each: each}), nil
+	}
+
+	if strings.HasPrefix(content, "/") {
+		kind := strings.TrimSpace(content[1:])
+		if len(stack) == 1 {
+			return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s does not close an open block", raw)}
+		}
+		if !strings.EqualFold(kind, current.kind) {
+			return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s cannot close %s", raw, current.raw)}
+		}
+		return stack[:len(stack)-1], nil
+	}
+
+	parsed, err := parseExpression(content, offset)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.symbol == "" && len(parsed.filters) == 0 {
+		return nil, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%s is empty", raw)}
+	}
+	current.append(&substitutionNode{raw: raw, expression: parsed})
+	return stack, nil
+}
+
+func splitKeyword(content string) (string, string) {
+	keyword, rest, _ := strings.Cut(content, " ")
+	switch strings.ToLower(keyword) {
+	case "if", "unless", "each", "else":
+		return strings.ToLower(keyword), strings.TrimSpace(rest)
+	}
+	return "", content
+}
+
+// findExpressionEnd returns the offset of the brace closing the expression opened at start, allowing for nested
+// expressions and quoted strings.
+func findExpressionEnd(template string, start int) (int, error) {
+	depth := 0
+	inQuotes := false
+
+	for i := start + 1; i < len(template); i++ {
+		switch c := template[i]; {
+		case inQuotes && c == '\\':
+			i++
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, &SyntaxError{Offset: start, Message: fmt.Sprintf("the expression %q is not closed with '}'", truncate(template[start:], 40))}
+}
+
+func parseExpression(content string, offset int) (expression, error) {
+	parts := splitOutside(content, '|')
+
+	parsed := expression{symbol: strings.TrimSpace(parts[0])}
+	if parsed.symbol != "" {
+		if err := checkSymbol(parsed.symbol, offset); err != nil {
+			return expression{}, err
+		}
+	}
+
+	for _, part := range parts[1:] {
+		words, err := splitWords(part)
+		if err != nil {
+			return expression{}, &SyntaxError{Offset: offset, Message: err.Error()}
+		}
+		if len(words) == 0 {
+			return expression{}, &SyntaxError{Offset: offset, Message: fmt.Sprintf("the expression %q has an empty filter", content)}
+		}
+		if !filterNamePattern.MatchString(words[0]) {
+			return expression{}, &SyntaxError{Offset: offset, Message: fmt.Sprintf("%q is not a valid filter name", words[0])}
+		}
+		parsed.filters = append(parsed.filters, filterCall{name: words[0], arguments: words[1:]})
+	}
+
+	return parsed, nil
+}
+
+func parseCondition(content string, offset int) (condition, error) {
+	for _, operator := range []string{"==", "!="} {
+		index := indexOutside(content, operator)
+		if index < 0 {
+			continue
+		}
+
+		left, err := parseExpression(strings.TrimSpace(content[:index]), offset)
+		if err != nil {
+			return condition{}, err
+		}
+
+		parsed := condition{left: left, operator: operator}
+		right := strings.TrimSpace(content[index+len(operator):])
+		if literal, ok := unquote(right); ok {
+			parsed.literal = literal
+		} else {
+			rightExpression, err := parseExpression(right, offset)
+			if err != nil {
+				return condition{}, err
+			}
+			if rightExpression.symbol == "" {
+				return condition{}, &SyntaxError{Offset: offset, Message: fmt.Sprintf("the condition %q has nothing to compare with", content)}
+			}
+			parsed.right = &rightExpression
+		}
+
+		if left.symbol == "" {
+			return condition{}, &SyntaxError{Offset: offset, Message: fmt.Sprintf("the condition %q has nothing to compare", content)}
+		}
+		return parsed, nil
+	}
+
+	left, err := parseExpression(content, offset)
+	if err != nil {
+		return condition{}, err
+	}
+	return condition{left: left}, nil
+}
+
+// checkSymbol reports unbalanced index brackets in a variable name.
+func checkSymbol(symbol string, offset int) error {
+	depth := 0
+	for i := 0; i < len(symbol); i++ {
+		switch symbol[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return &SyntaxError{Offset: offset, Message: fmt.Sprintf("the variable name %q has an unmatched ']'", symbol)}
+			}
+		}
+	}
+	if depth != 0 {
+		return &SyntaxError{Offset: offset, Message: fmt.Sprintf("the variable name %q has an unmatched '['", symbol)}
+	}
+	return nil
+}
+
+// splitOutside splits value on separator, ignoring separators inside quotes, index brackets and nested expressions.
+func splitOutside(value string, separator byte) []string {
+	var parts []string
+	depth := 0
+	inQuotes := false
+	last := 0
+
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case inQuotes && c == '\\':
+			i++
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == separator && depth == 0:
+			parts = append(parts, value[last:i])
+			last = i + 1
+		}
+	}
+
+	return append(parts, value[last:])
+}
+
+func indexOutside(value string, token string) int {
+	depth := 0
+	inQuotes := false
+
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case inQuotes && c == '\\':
+			i++
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(value[i:], token):
+			return i
+		}
+	}
+
+	return -1
+}
+
+// splitWords splits the words of a filter call, keeping quoted arguments together.
+func splitWords(value string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	inQuotes := false
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case inQuotes && c == '\\' && i+1 < len(value) && (value[i+1] == '"' || value[i+1] == '\\'):
+			// Only quotes and backslashes are escaped, so regular expressions such as \d keep their backslash.
+			i++
+			word.WriteByte(value[i])
+		case c == '"':
+			inQuotes = !inQuotes
+			inWord = true
+		case !inQuotes && (c == ' ' || c == '\t'):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("the filter %q has an unterminated string", strings.TrimSpace(value))
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func unquote(value string) (string, bool) {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", false
+	}
+
+	words, err := splitWords(value)
+	if err != nil || len(words) != 1 {
+		return "", false
+	}
+	return words[0], true
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	return value[:length] + "..."
+}
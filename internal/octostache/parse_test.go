@@ -0,0 +1,61 @@
+package octostache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAcceptsWellFormedTemplates(t *testing.T) {
+	for _, template := range []string{
+		"",
+		"no expressions",
+		"#{Name}",
+		"#{Octopus.Action[Deploy Web].Output.Url}",
+		"#{Octopus.Action[#{StepName}].Output.Url}",
+		"#{Name | ToUpper | Replace \"-\" \"_\"}",
+		"#{if Enabled}on#{else}off#{/if}",
+		"#{unless Enabled}off#{/unless}",
+		"#{if Environment == \"Production\"}prod#{/if}",
+		"#{if Environment != Other}different#{/if}",
+		"#{each server in Servers}#{server}#{/each}",
+		"#{each a in A}#{each b in B}#{a}#{b}#{/each}#{/each}",
+		"##{NotAnExpression",
+		"${shell} and # and { }",
+	} {
+		require.NoError(t, Validate(template), template)
+	}
+}
+
+func TestValidateRejectsMalformedTemplates(t *testing.T) {
+	for template, message := range map[string]string{
+		"#{Name":                      "is not closed with '}'",
+		"#{}":                         "is empty",
+		"#{if Enabled}on":             "is not closed with #{/if}",
+		"#{each s in Servers}":        "is not closed with #{/each}",
+		"#{/if}":                      "does not close an open block",
+		"#{if Enabled}#{/each}":       "cannot close",
+		"#{else}":                     "is not inside",
+		"#{if A}#{else}#{else}#{/if}": "is not inside",
+		"#{if}x#{/if}":                "has no condition",
+		"#{each Servers}#{/each}":     "must be in the form",
+		"#{Servers[Web}":              "unmatched '['",
+		"#{Name | }":                  "has an empty filter",
+		"#{Name | 1Bad}":              "is not a valid filter name",
+		"#{Name | Replace \"a}":       "is not closed with '}'",
+		"#{if A == }x#{/if}":          "nothing to compare with",
+	} {
+		err := Validate(template)
+		require.Error(t, err, template)
+		require.Contains(t, err.Error(), message, template)
+	}
+}
+
+func TestSyntaxErrorReportsOffset(t *testing.T) {
+	err := Validate("prefix #{Name")
+	require.Error(t, err)
+
+	var syntaxError *SyntaxError
+	require.ErrorAs(t, err, &syntaxError)
+	require.Equal(t, 7, syntaxError.Offset)
+}
@@ -0,0 +1,255 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	GitHubActionsRequestURLEnvVar   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	GitHubActionsRequestTokenEnvVar = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+
+	maxRefreshMargin = 2 * time.Minute
+)
+
+// Options describes where the identity token issued by a CI system comes from and which service account it is
+// exchanged for.
+type Options struct {
+	ServerURL           string
+	ServiceAccountID    string
+	Audience            string
+	IdentityToken       string
+	IdentityTokenFile   string
+	IdentityTokenEnvVar string
+	TokenRequestURL     string
+	TokenRequestToken   string
+}
+
+// TokenSource exchanges an identity token for an Octopus access token and exchanges it again shortly before the
+// access token expires.
+type TokenSource struct {
+	options    Options
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu            sync.Mutex
+	accessToken   string
+	expiry        time.Time
+	refreshMargin time.Duration
+}
+
+func NewTokenSource(options Options, httpClient *http.Client) (*TokenSource, error) {
+	if options.ServiceAccountID == "" {
+		return nil, fmt.Errorf("a service account ID is required to exchange an identity token")
+	}
+
+	if options.Audience == "" {
+		options.Audience = options.ServiceAccountID
+	}
+
+	if options.IdentityToken == "" && options.IdentityTokenFile == "" && options.IdentityTokenEnvVar == "" && options.TokenRequestURL == "" {
+		options.TokenRequestURL = os.Getenv(GitHubActionsRequestURLEnvVar)
+		if options.TokenRequestToken == "" {
+			options.TokenRequestToken = os.Getenv(GitHubActionsRequestTokenEnvVar)
+		}
+	}
+
+	if options.IdentityToken == "" && options.IdentityTokenFile == "" && options.IdentityTokenEnvVar == "" && options.TokenRequestURL == "" {
+		return nil, fmt.Errorf("an identity token, identity token file, identity token environment variable or token request URL is required to exchange an identity token")
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	return &TokenSource{
+		options:    options,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// Token returns the current access token, exchanging a new identity token when the access token is about to expire.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && s.now().Add(s.refreshMargin).Before(s.expiry) {
+		return s.accessToken, nil
+	}
+
+	identityToken, err := s.identityToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	accessToken, lifetime, err := s.exchange(ctx, identityToken)
+	if err != nil {
+		return "", err
+	}
+
+	s.accessToken = accessToken
+	s.expiry = s.now().Add(lifetime)
+	s.refreshMargin = min(maxRefreshMargin, lifetime/4)
+
+	return s.accessToken, nil
+}
+
+func (s *TokenSource) identityToken(ctx context.Context) (string, error) {
+	if s.options.IdentityToken != "" {
+		return s.options.IdentityToken, nil
+	}
+
+	if s.options.IdentityTokenFile != "" {
+		content, err := os.ReadFile(s.options.IdentityTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read identity token file: %w", err)
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+
+	if s.options.IdentityTokenEnvVar != "" {
+		token := os.Getenv(s.options.IdentityTokenEnvVar)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s does not contain an identity token", s.options.IdentityTokenEnvVar)
+		}
+		return token, nil
+	}
+
+	return s.requestIdentityToken(ctx)
+}
+
+// requestIdentityToken asks the CI system for a new identity token, the way the GitHub Actions token request URL expects.
+func (s *TokenSource) requestIdentityToken(ctx context.Context) (string, error) {
+	requestURL, err := url.Parse(s.options.TokenRequestURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid token request URL: %w", err)
+	}
+
+	query := requestURL.Query()
+	query.Set("audience", s.options.Audience)
+	requestURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.options.TokenRequestToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.options.TokenRequestToken))
+	}
+
+	var response struct {
+		Value string `json:"value"`
+	}
+	if err := s.do(req, &response); err != nil {
+		return "", fmt.Errorf("failed to request identity token: %w", err)
+	}
+
+	if response.Value == "" {
+		return "", fmt.Errorf("the token request URL did not return an identity token")
+	}
+
+	return response.Value, nil
+}
+
+func (s *TokenSource) exchange(ctx context.Context, identityToken string) (string, time.Duration, error) {
+	tokenEndpoint, err := s.tokenEndpoint(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"grant_type":         tokenExchangeGrantType,
+		"audience":           s.options.ServiceAccountID,
+		"subject_token":      identityToken,
+		"subject_token_type": jwtTokenType,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	var response struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresIn   json.Number `json:"expires_in"`
+	}
+	if err := s.do(req, &response); err != nil {
+		return "", 0, fmt.Errorf("failed to exchange identity token for service account %s: %w", s.options.ServiceAccountID, err)
+	}
+
+	if response.AccessToken == "" {
+		return "", 0, fmt.Errorf("the Octopus Server did not return an access token for service account %s", s.options.ServiceAccountID)
+	}
+
+	expiresIn, err := strconv.ParseInt(response.ExpiresIn.String(), 10, 64)
+	if err != nil || expiresIn <= 0 {
+		return "", 0, fmt.Errorf("the Octopus Server returned an invalid access token lifetime %q", response.ExpiresIn)
+	}
+
+	return response.AccessToken, time.Duration(expiresIn) * time.Second, nil
+}
+
+func (s *TokenSource) tokenEndpoint(ctx context.Context) (string, error) {
+	discoveryURL, err := url.JoinPath(s.options.ServerURL, "/.well-known/openid-configuration")
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var configuration struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := s.do(req, &configuration); err != nil {
+		return "", fmt.Errorf("failed to read the OpenID configuration of the Octopus Server: %w", err)
+	}
+
+	if configuration.TokenEndpoint == "" {
+		return "", fmt.Errorf("the OpenID configuration of the Octopus Server does not include a token endpoint")
+	}
+
+	return configuration.TokenEndpoint, nil
+}
+
+func (s *TokenSource) do(req *http.Request, result any) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s returned %s: %s", req.Method, req.URL.Redacted(), resp.Status, strings.TrimSpace(string(content)))
+	}
+
+	return json.Unmarshal(content, result)
+}
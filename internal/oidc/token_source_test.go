@@ -0,0 +1,157 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOctopusServer struct {
+	*httptest.Server
+	exchanges      int32
+	identityTokens []string
+}
+
+func newTestOctopusServer(t *testing.T) *testOctopusServer {
+	server := &testOctopusServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": server.URL + "/token/v1"})
+	})
+	mux.HandleFunc("/token/v1", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, tokenExchangeGrantType, body["grant_type"])
+		require.Equal(t, jwtTokenType, body["subject_token_type"])
+		require.Equal(t, "service-account-id", body["audience"])
+		server.identityTokens = append(server.identityTokens, body["subject_token"])
+
+		exchange := atomic.AddInt32(&server.exchanges, 1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("access-token-%d", exchange),
+			"token_type":   "Bearer",
+			"expires_in":   "3600",
+		})
+	})
+	mux.HandleFunc("/github/token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		require.Equal(t, "service-account-id", r.URL.Query().Get("audience"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"value": "github-identity-token"})
+	})
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTokenSourceExchangesIdentityToken(t *testing.T) {
+	server := newTestOctopusServer(t)
+
+	source, err := NewTokenSource(Options{
+		ServerURL:        server.URL,
+		ServiceAccountID: "service-account-id",
+		IdentityToken:    "identity-token",
+	}, server.Client())
+	require.NoError(t, err)
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token-1", token)
+	require.Equal(t, []string{"identity-token"}, server.identityTokens)
+}
+
+func TestTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	server := newTestOctopusServer(t)
+
+	source, err := NewTokenSource(Options{
+		ServerURL:        server.URL,
+		ServiceAccountID: "service-account-id",
+		IdentityToken:    "identity-token",
+	}, server.Client())
+	require.NoError(t, err)
+
+	now := time.Now()
+	source.now = func() time.Time { return now }
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token-1", token)
+
+	now = now.Add(50 * time.Minute)
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token-1", token)
+
+	now = now.Add(9 * time.Minute)
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token-2", token)
+}
+
+func TestTokenSourceReadsIdentityTokenFile(t *testing.T) {
+	server := newTestOctopusServer(t)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-identity-token\n"), 0600))
+
+	source, err := NewTokenSource(Options{
+		ServerURL:         server.URL,
+		ServiceAccountID:  "service-account-id",
+		IdentityTokenFile: tokenFile,
+	}, server.Client())
+	require.NoError(t, err)
+
+	_, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"file-identity-token"}, server.identityTokens)
+}
+
+func TestTokenSourceReadsIdentityTokenEnvVar(t *testing.T) {
+	server := newTestOctopusServer(t)
+	t.Setenv("TEST_OCTOPUS_ID_TOKEN", "env-identity-token")
+
+	source, err := NewTokenSource(Options{
+		ServerURL:           server.URL,
+		ServiceAccountID:    "service-account-id",
+		IdentityTokenEnvVar: "TEST_OCTOPUS_ID_TOKEN",
+	}, server.Client())
+	require.NoError(t, err)
+
+	_, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"env-identity-token"}, server.identityTokens)
+}
+
+func TestTokenSourceRequestsGitHubActionsIdentityToken(t *testing.T) {
+	server := newTestOctopusServer(t)
+	t.Setenv(GitHubActionsRequestURLEnvVar, server.URL+"/github/token?api-version=2.0")
+	t.Setenv(GitHubActionsRequestTokenEnvVar, "request-token")
+
+	source, err := NewTokenSource(Options{
+		ServerURL:        server.URL,
+		ServiceAccountID: "service-account-id",
+	}, server.Client())
+	require.NoError(t, err)
+
+	_, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"github-identity-token"}, server.identityTokens)
+}
+
+func TestNewTokenSourceRequiresIdentityTokenSource(t *testing.T) {
+	t.Setenv(GitHubActionsRequestURLEnvVar, "")
+
+	_, err := NewTokenSource(Options{ServiceAccountID: "service-account-id"}, nil)
+	require.Error(t, err)
+
+	_, err = NewTokenSource(Options{IdentityToken: "identity-token"}, nil)
+	require.Error(t, err)
+}
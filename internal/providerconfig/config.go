@@ -0,0 +1,426 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/version"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/configuration"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidc"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/spaceresolver"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/transport"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Settings holds the provider attributes shared by the SDKv2 and framework providers.
+type Settings struct {
+	Address     string
+	APIKey      string
+	AccessToken string
+	SpaceID     string
+	SpaceName   string
+	SpaceSlug   string
+
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	CACertificateFile  string
+	CACertificatePEM   string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+	ProxyURL           string
+	RequestTimeout     time.Duration
+	CacheReads         bool
+
+	MaxConcurrentRequests int
+	RequestsPerSecond     int
+
+	RequiredServerVersion  string
+	RequiredFeatureToggles []string
+
+	// ReadOnly refuses every change to resources, and every request that is not a read.
+	ReadOnly bool
+
+	OIDC *oidc.Options
+}
+
+// Config is the configuration shared by the SDKv2 and framework providers: the client connected to the Octopus Server
+// and what is known about that server.
+type Config struct {
+	Settings
+
+	Client         *client.Client
+	OctopusVersion string
+	FeatureToggles map[string]bool
+
+	mu          sync.Mutex
+	loaded      bool
+	tokenSource *oidc.TokenSource
+
+	httpClientOnce sync.Once
+	httpClient     *http.Client
+	httpClientErr  error
+}
+
+// LoadError reports which step of loading the configuration failed.
+type LoadError struct {
+	Summary string
+	Err     error
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Summary, e.Err)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+var shared sync.Map
+
+// Shared returns the configuration for settings, creating it on first use. The muxed providers are configured with
+// the same attributes, so they share one configuration and one client. Settings are compared once normalised, because
+// the providers do not read unset attributes the same way.
+func Shared(settings Settings) *Config {
+	settings = settings.normalized()
+	key, err := json.Marshal(settings)
+	if err != nil {
+		return &Config{Settings: settings}
+	}
+
+	config, _ := shared.LoadOrStore(string(key), &Config{Settings: settings})
+	return config.(*Config)
+}
+
+// normalized returns the settings with an empty list of required feature toggles, which is how the SDKv2 provider
+// reads the unset attribute, replaced by no list, which is how the framework provider reads it.
+func (s Settings) normalized() Settings {
+	if len(s.RequiredFeatureToggles) == 0 {
+		s.RequiredFeatureToggles = nil
+	}
+	return s
+}
+
+// Load builds the client, reads the server version and feature toggles, and checks them against the provider's
+// requirements. It only talks to the server the first time it succeeds; later calls return immediately.
+func (c *Config) Load(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		tflog.Debug(ctx, "Load: reusing the shared provider configuration")
+		return nil
+	}
+
+	if clientError := c.GetClient(ctx); clientError != nil {
+		return &LoadError{Summary: "failed to load client", Err: clientError}
+	}
+
+	if versionError := c.SetOctopusVersion(ctx); versionError != nil {
+		return &LoadError{Summary: "failed to load Octopus Server version", Err: versionError}
+	}
+
+	if featuresError := c.SetFeatureToggles(ctx); featuresError != nil {
+		return &LoadError{Summary: "failed to load feature toggles", Err: featuresError}
+	}
+
+	if versionError := c.checkRequiredServerVersion(); versionError != nil {
+		return &LoadError{Summary: "unsupported Octopus Server version", Err: versionError}
+	}
+
+	if featuresError := c.checkRequiredFeatureToggles(); featuresError != nil {
+		return &LoadError{Summary: "required feature toggles are not enabled", Err: featuresError}
+	}
+
+	c.loaded = true
+	return nil
+}
+
+func (c *Config) GetClient(ctx context.Context) error {
+	tflog.Debug(ctx, "GetClient")
+
+	octopus, err := c.getClientForSpace(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	resolver := spaceresolver.NewResolver(octopus)
+
+	if len(c.SpaceID) > 0 {
+		space, err := spaces.GetByID(octopus, c.SpaceID)
+		if err != nil {
+			return err
+		}
+
+		octopus, err = c.getClientForSpace(ctx, space.GetID())
+		if err != nil {
+			return err
+		}
+	} else if len(c.SpaceName) > 0 || len(c.SpaceSlug) > 0 {
+		space, err := c.resolveSpace(resolver)
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("GetClient: Resolved space %s", space.GetID()))
+		c.SpaceID = space.GetID()
+		octopus, err = c.getClientForSpace(ctx, space.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
+	spaceresolver.Register(octopus, resolver)
+	c.Client = octopus
+
+	createdClient := octopus != nil
+	tflog.Debug(ctx, fmt.Sprintf("GetClient completed: %t", createdClient))
+	return nil
+}
+
+func (c *Config) SetFeatureToggles(ctx context.Context) error {
+	tflog.Debug(ctx, "SetFeatureToggles")
+
+	response, err := configuration.Get(c.Client, &configuration.FeatureToggleConfigurationQuery{})
+	if err != nil {
+		return err
+	}
+
+	features := make(map[string]bool, len(response.FeatureToggles))
+	for _, feature := range response.FeatureToggles {
+		features[feature.Name] = feature.IsEnabled
+	}
+
+	c.FeatureToggles = features
+
+	tflog.Debug(ctx, fmt.Sprintf("SetFeatureToggles completed with %d features", len(c.FeatureToggles)))
+	return nil
+}
+
+func (c *Config) SetOctopusVersion(ctx context.Context) error {
+	tflog.Debug(ctx, "SetOctopusVersion")
+
+	root, err := client.GetServerRoot(c.Client)
+	if err != nil {
+		return err
+	}
+
+	c.OctopusVersion = root.Version
+	tflog.Debug(ctx, fmt.Sprintf("SetOctopusVersion completed with %s", c.OctopusVersion))
+
+	return nil
+}
+
+// FeatureToggleEnabled Reports whether feature toggle enabled on connected Octopus Server instance.
+//
+// Returns true for enabled toggle and false for disabled or non-existent feature toggle
+func (c *Config) FeatureToggleEnabled(toggle string) bool {
+	if enabled, ok := c.FeatureToggles[toggle]; ok {
+		return enabled
+	}
+
+	return false
+}
+
+func (c *Config) IsVersionSameOrGreaterThan(minVersion string) bool {
+	if c.OctopusVersion == "0.0.0-local" {
+		return true // Always true for local instance
+	}
+
+	diff := version.Compare(fmt.Sprintf("go%s", c.OctopusVersion), fmt.Sprintf("go%s", minVersion))
+
+	return diff == 1 || diff == 0
+}
+
+// Incompatibility describes why a resource cannot be used with the connected Octopus Server.
+type Incompatibility struct {
+	Summary string
+	Detail  string
+}
+
+// CheckResourceCompatibilityByFeature returns why resource is incompatible with the connected Octopus Server, or nil
+// when the feature toggle it requires is enabled.
+func (c *Config) CheckResourceCompatibilityByFeature(resourceName string, toggle string) *Incompatibility {
+	if c.FeatureToggleEnabled(toggle) {
+		return nil
+	}
+
+	return &Incompatibility{
+		Summary: fmt.Sprintf("The '%s' resource is not supported by the connected Octopus Deploy instance", resourceName),
+		Detail:  fmt.Sprintf("This resource requires feature toggle '%s' to be enabled.", toggle),
+	}
+}
+
+// CheckResourceCompatibilityByVersion returns why resource is incompatible with the connected Octopus Server, or nil
+// when the server is running version or later.
+func (c *Config) CheckResourceCompatibilityByVersion(resourceName string, version string) *Incompatibility {
+	if c.IsVersionSameOrGreaterThan(version) {
+		return nil
+	}
+
+	return &Incompatibility{
+		Summary: fmt.Sprintf("The '%s' resource is not supported by the current Octopus Deploy server version", resourceName),
+		Detail:  fmt.Sprintf("This resource requires Octopus Deploy server version %s or later. The connected server is running version %s, which is incompatible with this resource.", version, c.OctopusVersion),
+	}
+}
+
+// resolveSpace finds the space targeted by the provider's space_name or space_slug.
+func (c *Config) resolveSpace(resolver *spaceresolver.Resolver) (*spaces.Space, error) {
+	if len(c.SpaceName) > 0 {
+		return resolver.ResolveByName(c.SpaceName)
+	}
+
+	return resolver.ResolveBySlug(c.SpaceSlug)
+}
+
+func (c *Config) getClientForSpace(ctx context.Context, spaceID string) (*client.Client, error) {
+	apiURL, err := url.Parse(c.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := c.getApiCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := c.getHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewClientWithCredentials(httpClient, apiURL, credential, spaceID, "TerraformProvider")
+}
+
+// getHTTPClient returns the HTTP client shared by every client built from this configuration, so requests are
+// throttled, and reads are cached, across the whole provider rather than per space.
+func (c *Config) getHTTPClient(ctx context.Context) (*http.Client, error) {
+	c.httpClientOnce.Do(func() {
+		c.httpClient, c.httpClientErr = transport.NewHTTPClient(c.transportOptions(ctx))
+	})
+	return c.httpClient, c.httpClientErr
+}
+
+func (c *Config) transportOptions(ctx context.Context) transport.Options {
+	options := c.connectionOptions()
+	options.ReadOnly = c.ReadOnly
+	options.CacheReads = c.CacheReads
+	options.MaxConcurrentRequests = c.MaxConcurrentRequests
+	options.RequestsPerSecond = c.RequestsPerSecond
+	options.LogContext = ctx
+
+	if c.tokenSource != nil {
+		options.TokenSource = c.tokenSource
+	}
+
+	return options
+}
+
+// connectionOptions returns the transport options that only describe how to reach the server: retries, TLS, the proxy
+// and the request timeout.
+func (c *Config) connectionOptions() transport.Options {
+	return transport.Options{
+		MaxRetries:   c.MaxRetries,
+		RetryWaitMin: c.RetryWaitMin,
+		RetryWaitMax: c.RetryWaitMax,
+
+		CACertificateFile:  c.CACertificateFile,
+		CACertificatePEM:   c.CACertificatePEM,
+		ClientCertificate:  c.ClientCertificate,
+		ClientKey:          c.ClientKey,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		ProxyURL:           c.ProxyURL,
+		RequestTimeout:     c.RequestTimeout,
+	}
+}
+
+func (c *Config) getApiCredential(ctx context.Context) (client.ICredential, error) {
+	tflog.Debug(ctx, "GetClient: Trying the following auth methods in order of priority - APIKey, AccessToken, OIDC")
+
+	if c.APIKey != "" {
+		tflog.Debug(ctx, "GetClient: Attempting to authenticate with API Key")
+		credential, err := client.NewApiKey(c.APIKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return credential, nil
+	} else {
+		tflog.Debug(ctx, "GetClient: No API Key found")
+	}
+
+	if c.AccessToken != "" {
+		tflog.Debug(ctx, "GetClient: Attempting to authenticate with Access Token")
+		credential, err := client.NewAccessToken(c.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		return credential, nil
+	} else {
+		tflog.Debug(ctx, "GetClient: No Access Token found")
+	}
+
+	if c.OIDC != nil {
+		tflog.Debug(ctx, "GetClient: Attempting to authenticate by exchanging an OIDC identity token")
+		tokenSource, err := c.getTokenSource(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		accessToken, err := tokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.NewAccessToken(accessToken)
+	} else {
+		tflog.Debug(ctx, "GetClient: No OIDC configuration found")
+	}
+
+	return nil, fmt.Errorf("either an APIKey, an AccessToken or an OIDC configuration is required to connect to the Octopus Server instance")
+}
+
+// getTokenSource creates the token source shared by every client built from this configuration, so the exchanged
+// access token is refreshed in one place.
+func (c *Config) getTokenSource(ctx context.Context) (*oidc.TokenSource, error) {
+	if c.tokenSource != nil {
+		return c.tokenSource, nil
+	}
+
+	// The token client only connects to the server: a new identity token must never be answered from the read cache,
+	// identity and access tokens must not be written to the trace log, and exchanging the identity token is a POST,
+	// which a read-only transport would refuse.
+	httpClient, err := transport.NewHTTPClient(c.connectionOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	options := *c.OIDC
+	options.ServerURL = c.Address
+
+	tokenSource, err := oidc.NewTokenSource(options, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c.tokenSource = tokenSource
+	return tokenSource, nil
+}
+
+// ReadOnlySummary is the summary of the error reported when the read-only provider refuses a change.
+const ReadOnlySummary = "The provider is read only"
+
+// ReadOnlyDetail explains why the read-only provider refused to create, update or delete resourceName.
+func ReadOnlyDetail(operation string, resourceName string) string {
+	return fmt.Sprintf("Refusing to %s '%s' because the provider is configured with read_only = true or OCTOPUS_READ_ONLY. Remove the setting to let Terraform change resources.", operation, resourceName)
+}
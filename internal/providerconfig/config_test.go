@@ -0,0 +1,129 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedReturnsSameConfigForSameSettings(t *testing.T) {
+	settings := Settings{Address: "https://shared.example.com", APIKey: "API-SHARED", SpaceID: "Spaces-1"}
+
+	first := Shared(settings)
+	second := Shared(settings)
+	require.Same(t, first, second)
+
+	other := Shared(Settings{Address: "https://shared.example.com", APIKey: "API-SHARED", SpaceID: "Spaces-2"})
+	require.NotSame(t, first, other)
+}
+
+func TestSharedTreatsEmptyRequiredFeatureTogglesAsUnset(t *testing.T) {
+	unset := Shared(Settings{Address: "https://toggles.example.com", APIKey: "API-TOGGLES"})
+	empty := Shared(Settings{Address: "https://toggles.example.com", APIKey: "API-TOGGLES", RequiredFeatureToggles: []string{}})
+	require.Same(t, unset, empty)
+}
+
+func TestLoadReusesLoadedConfig(t *testing.T) {
+	config := &Config{loaded: true}
+	require.NoError(t, config.Load(context.Background()))
+	require.Nil(t, config.Client)
+}
+
+func TestLoadReportsClientError(t *testing.T) {
+	config := &Config{Settings: Settings{Address: "https://example.com"}}
+
+	err := config.Load(context.Background())
+	var loadError *LoadError
+	require.ErrorAs(t, err, &loadError)
+	require.Equal(t, "failed to load client", loadError.Summary)
+}
+
+func TestCheckResourceCompatibilityByVersion(t *testing.T) {
+	config := &Config{OctopusVersion: "2024.4.1000"}
+
+	require.Nil(t, config.CheckResourceCompatibilityByVersion("octopusdeploy_example", "2024.4"))
+
+	incompatibility := config.CheckResourceCompatibilityByVersion("octopusdeploy_example", "2025.1")
+	require.NotNil(t, incompatibility)
+	require.Contains(t, incompatibility.Summary, "octopusdeploy_example")
+	require.Contains(t, incompatibility.Detail, "2025.1")
+	require.Contains(t, incompatibility.Detail, "2024.4.1000")
+}
+
+func TestCheckResourceCompatibilityByFeature(t *testing.T) {
+	config := &Config{FeatureToggles: map[string]bool{"EnabledFeatureToggle": true, "DisabledFeatureToggle": false}}
+
+	require.Nil(t, config.CheckResourceCompatibilityByFeature("octopusdeploy_example", "EnabledFeatureToggle"))
+	require.NotNil(t, config.CheckResourceCompatibilityByFeature("octopusdeploy_example", "DisabledFeatureToggle"))
+	require.NotNil(t, config.CheckResourceCompatibilityByFeature("octopusdeploy_example", "MissingFeatureToggle"))
+}
+
+func TestTokenSourceRequestsNewIdentityTokenWhenCachingReads(t *testing.T) {
+	var identityTokenRequests int32
+	var exchangedTokens []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": server.URL + "/token/v1"})
+	})
+	mux.HandleFunc("/token/v1", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		exchangedTokens = append(exchangedTokens, body["subject_token"])
+		// The access token expires almost at once, so the next call to Token exchanges a new identity token.
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "access-token", "expires_in": 1})
+	})
+	mux.HandleFunc("/github/token", func(w http.ResponseWriter, r *http.Request) {
+		request := atomic.AddInt32(&identityTokenRequests, 1)
+		_ = json.NewEncoder(w).Encode(map[string]string{"value": fmt.Sprintf("identity-token-%d", request)})
+	})
+
+	config := &Config{Settings: Settings{
+		Address:    server.URL,
+		CacheReads: true,
+		OIDC: &oidc.Options{
+			ServiceAccountID:  "service-account-id",
+			TokenRequestURL:   server.URL + "/github/token",
+			TokenRequestToken: "request-token",
+		},
+	}}
+
+	tokenSource, err := config.getTokenSource(context.Background())
+	require.NoError(t, err)
+
+	_, err = tokenSource.Token(context.Background())
+	require.NoError(t, err)
+	time.Sleep(800 * time.Millisecond)
+	_, err = tokenSource.Token(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&identityTokenRequests))
+	require.Equal(t, []string{"identity-token-1", "identity-token-2"}, exchangedTokens)
+}
+
+func TestClientsForEverySpaceShareOneHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"Application": "Octopus Deploy", "Version": "2025.1.0", "Links": map[string]string{}})
+	}))
+	t.Cleanup(server.Close)
+
+	config := &Config{Settings: Settings{Address: server.URL, APIKey: "API-SHAREDCLIENT", CacheReads: true, MaxConcurrentRequests: 2}}
+
+	firstSpace, err := config.getClientForSpace(context.Background(), "Spaces-1")
+	require.NoError(t, err)
+	secondSpace, err := config.getClientForSpace(context.Background(), "Spaces-2")
+	require.NoError(t, err)
+
+	require.Same(t, firstSpace.HttpSession().HttpClient, secondSpace.HttpSession().HttpClient)
+}
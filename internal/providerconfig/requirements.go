@@ -0,0 +1,153 @@
+package providerconfig
+
+import (
+	"fmt"
+	"go/version"
+	"sort"
+	"strings"
+)
+
+// VersionRequirement names the first Octopus Server version to support a resource, or one attribute of a resource.
+type VersionRequirement struct {
+	Resource string
+	// Attribute is the top-level attribute or block the requirement applies to, or empty when it applies to the whole
+	// resource.
+	Attribute string
+	Version   string
+}
+
+// versionRequirements is the version matrix checked when resources are planned, so configuration an older server
+// cannot accept is reported before anything is applied. It lists the resources and attributes whose minimum server
+// version is known, which are also listed in the Octopus Server compatibility guide. Anything else is left for the
+// server to reject.
+var versionRequirements = []VersionRequirement{
+	{Resource: "octopusdeploy_deployment", Version: "2022.3"},
+	{Resource: "octopusdeploy_deployment_freeze", Version: "2025.1"},
+	{Resource: "octopusdeploy_deployment_freeze", Attribute: "recurring_schedule", Version: "2025.2"},
+	{Resource: "octopusdeploy_deployment_freeze_project", Version: "2025.1"},
+	{Resource: "octopusdeploy_deployment_freeze_tenant", Version: "2025.1"},
+	{Resource: "octopusdeploy_release", Version: "2022.3"},
+	{Resource: "octopusdeploy_runbook_run", Version: "2022.3"},
+}
+
+// VersionRequirements returns the entries of the version matrix for resourceName.
+func VersionRequirements(resourceName string) []VersionRequirement {
+	var requirements []VersionRequirement
+	for _, requirement := range versionRequirements {
+		if requirement.Resource == resourceName {
+			requirements = append(requirements, requirement)
+		}
+	}
+	return requirements
+}
+
+// CheckVersionRequirement returns why the connected Octopus Server cannot accept the resource or attribute named by
+// requirement, or nil when it is running a version that supports it.
+func (c *Config) CheckVersionRequirement(requirement VersionRequirement) *Incompatibility {
+	if requirement.Attribute == "" {
+		return c.CheckResourceCompatibilityByVersion(requirement.Resource, requirement.Version)
+	}
+
+	if c.IsVersionSameOrGreaterThan(requirement.Version) {
+		return nil
+	}
+
+	return &Incompatibility{
+		Summary: fmt.Sprintf("The '%s' attribute of the '%s' resource is not supported by the current Octopus Deploy server version", requirement.Attribute, requirement.Resource),
+		Detail:  fmt.Sprintf("This attribute requires Octopus Deploy server version %s or later. The connected server is running version %s. Remove the attribute or upgrade the server.", requirement.Version, c.OctopusVersion),
+	}
+}
+
+// checkRequiredServerVersion reports an error when the connected server does not satisfy the provider's
+// required_server_version constraint.
+func (c *Config) checkRequiredServerVersion() error {
+	if c.RequiredServerVersion == "" || c.OctopusVersion == "0.0.0-local" {
+		return nil
+	}
+
+	constraints, err := parseVersionConstraint(c.RequiredServerVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, constraint := range constraints {
+		if !constraint.check(c.OctopusVersion) {
+			return fmt.Errorf("the provider requires an Octopus Server version matching %q, but the connected server is running version %s", c.RequiredServerVersion, c.OctopusVersion)
+		}
+	}
+
+	return nil
+}
+
+// checkRequiredFeatureToggles reports an error naming every toggle in the provider's required_feature_toggles that is
+// not enabled on the connected server.
+func (c *Config) checkRequiredFeatureToggles() error {
+	var disabled []string
+	for _, toggle := range c.RequiredFeatureToggles {
+		if !c.FeatureToggleEnabled(toggle) {
+			disabled = append(disabled, toggle)
+		}
+	}
+
+	if len(disabled) == 0 {
+		return nil
+	}
+
+	sort.Strings(disabled)
+	return fmt.Errorf("the provider requires the following feature toggles, which are not enabled on the connected Octopus Server: %s", strings.Join(disabled, ", "))
+}
+
+type versionConstraint struct {
+	operator string
+	version  string
+}
+
+var versionOperators = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// parseVersionConstraint parses a comma-separated list of constraints such as ">= 2024.3, < 2026". A version with no
+// operator must match exactly.
+func parseVersionConstraint(value string) ([]versionConstraint, error) {
+	var constraints []versionConstraint
+	for _, clause := range strings.Split(value, ",") {
+		clause = strings.TrimSpace(clause)
+
+		constraint := versionConstraint{operator: "=", version: clause}
+		for _, operator := range versionOperators {
+			if strings.HasPrefix(clause, operator) {
+				constraint = versionConstraint{operator: operator, version: strings.TrimSpace(strings.TrimPrefix(clause, operator))}
+				break
+			}
+		}
+
+		if !version.IsValid(goVersion(constraint.version)) {
+			return nil, fmt.Errorf("invalid version constraint %q: %q is not a version", value, constraint.version)
+		}
+
+		constraints = append(constraints, constraint)
+	}
+
+	return constraints, nil
+}
+
+func (v versionConstraint) check(serverVersion string) bool {
+	diff := version.Compare(goVersion(serverVersion), goVersion(v.version))
+
+	switch v.operator {
+	case ">=":
+		return diff >= 0
+	case "<=":
+		return diff <= 0
+	case ">":
+		return diff > 0
+	case "<":
+		return diff < 0
+	case "!=":
+		return diff != 0
+	default:
+		return diff == 0
+	}
+}
+
+func goVersion(octopusVersion string) string {
+	return fmt.Sprintf("go%s", octopusVersion)
+}
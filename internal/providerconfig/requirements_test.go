@@ -0,0 +1,64 @@
+package providerconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRequiredServerVersion(t *testing.T) {
+	for _, test := range []struct {
+		constraint string
+		version    string
+		satisfied  bool
+	}{
+		{"", "2020.1", true},
+		{">= 2024.3", "2024.3", true},
+		{">= 2024.3", "2024.3.12345", true},
+		{">= 2024.3", "2025.1", true},
+		{">= 2024.3", "2024.2.9999", false},
+		{">= 2024.3, < 2025.2", "2025.1", true},
+		{">= 2024.3, < 2025.2", "2025.2", false},
+		{"2025.1", "2025.1", true},
+		{"!= 2025.1", "2025.1", false},
+		{"> 2030", "0.0.0-local", true},
+	} {
+		config := &Config{Settings: Settings{RequiredServerVersion: test.constraint}, OctopusVersion: test.version}
+		err := config.checkRequiredServerVersion()
+		if test.satisfied {
+			require.NoError(t, err, "%q should be satisfied by %s", test.constraint, test.version)
+		} else {
+			require.Error(t, err, "%q should not be satisfied by %s", test.constraint, test.version)
+		}
+	}
+}
+
+func TestCheckRequiredServerVersionRejectsInvalidConstraint(t *testing.T) {
+	config := &Config{Settings: Settings{RequiredServerVersion: ">= latest"}, OctopusVersion: "2025.1"}
+	require.ErrorContains(t, config.checkRequiredServerVersion(), "not a version")
+}
+
+func TestCheckRequiredFeatureToggles(t *testing.T) {
+	config := &Config{
+		Settings:       Settings{RequiredFeatureToggles: []string{"EnabledFeatureToggle", "MissingFeatureToggle", "DisabledFeatureToggle"}},
+		FeatureToggles: map[string]bool{"EnabledFeatureToggle": true, "DisabledFeatureToggle": false},
+	}
+
+	err := config.checkRequiredFeatureToggles()
+	require.ErrorContains(t, err, "DisabledFeatureToggle, MissingFeatureToggle")
+	require.NotContains(t, err.Error(), "EnabledFeatureToggle,")
+
+	config.RequiredFeatureToggles = []string{"EnabledFeatureToggle"}
+	require.NoError(t, config.checkRequiredFeatureToggles())
+}
+
+func TestCheckVersionRequirement(t *testing.T) {
+	config := &Config{OctopusVersion: "2025.1.1000"}
+
+	require.Nil(t, config.CheckVersionRequirement(VersionRequirement{Resource: "octopusdeploy_example", Version: "2025.1"}))
+
+	incompatibility := config.CheckVersionRequirement(VersionRequirement{Resource: "octopusdeploy_example", Attribute: "example", Version: "2025.2"})
+	require.NotNil(t, incompatibility)
+	require.Contains(t, incompatibility.Summary, "'example' attribute")
+	require.Contains(t, incompatibility.Detail, "2025.2")
+}
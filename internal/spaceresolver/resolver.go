@@ -0,0 +1,127 @@
+package spaceresolver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+)
+
+var spaceIDPattern = regexp.MustCompile(`^Spaces-\d+$`)
+
+var resolvers sync.Map
+
+// Resolver finds spaces by ID, name or slug. The spaces on the server are listed once, on first use, and cached for
+// the lifetime of the resolver.
+type Resolver struct {
+	list func() ([]*spaces.Space, error)
+
+	once   sync.Once
+	spaces []*spaces.Space
+	err    error
+}
+
+func NewResolver(client newclient.Client) *Resolver {
+	return &Resolver{
+		list: func() ([]*spaces.Space, error) {
+			return spaces.GetAll(client)
+		},
+	}
+}
+
+// ForClient returns the resolver shared by every caller using the same client, so the spaces are listed once per
+// configured provider.
+func ForClient(client newclient.Client) *Resolver {
+	resolver, _ := resolvers.LoadOrStore(client, NewResolver(client))
+	return resolver.(*Resolver)
+}
+
+// Register makes resolver the one returned by ForClient for client, so spaces already listed while configuring the
+// provider are not listed again.
+func Register(client newclient.Client, resolver *Resolver) {
+	resolvers.Store(client, resolver)
+}
+
+// IsSpaceID reports whether reference is a space ID rather than a name or slug.
+func IsSpaceID(reference string) bool {
+	return spaceIDPattern.MatchString(reference)
+}
+
+func (r *Resolver) load() ([]*spaces.Space, error) {
+	r.once.Do(func() {
+		r.spaces, r.err = r.list()
+	})
+
+	return r.spaces, r.err
+}
+
+// ResolveByName returns the space with the given name.
+func (r *Resolver) ResolveByName(name string) (*spaces.Space, error) {
+	return r.find("name", name, func(space *spaces.Space) bool {
+		return strings.EqualFold(space.Name, name)
+	})
+}
+
+// ResolveBySlug returns the space with the given slug.
+func (r *Resolver) ResolveBySlug(slug string) (*spaces.Space, error) {
+	return r.find("slug", slug, func(space *spaces.Space) bool {
+		return strings.EqualFold(space.Slug, slug)
+	})
+}
+
+// Resolve returns the ID of the space referenced by an ID, name or slug.
+func (r *Resolver) Resolve(reference string) (string, error) {
+	if reference == "" || IsSpaceID(reference) {
+		return reference, nil
+	}
+
+	space, err := r.find("name or slug", reference, func(space *spaces.Space) bool {
+		return strings.EqualFold(space.Name, reference) || strings.EqualFold(space.Slug, reference)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return space.GetID(), nil
+}
+
+func (r *Resolver) find(kind string, value string, match func(space *spaces.Space) bool) (*spaces.Space, error) {
+	all, err := r.load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spaces: %w", err)
+	}
+
+	var matches []*spaces.Space
+	for _, space := range all {
+		if match(space) {
+			matches = append(matches, space)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no space with %s %q was found. The available spaces are: %s", kind, value, describe(all))
+	default:
+		return nil, fmt.Errorf("the %s %q matches more than one space: %s", kind, value, describe(matches))
+	}
+}
+
+func describe(all []*spaces.Space) string {
+	if len(all) == 0 {
+		return "(none)"
+	}
+
+	descriptions := make([]string, 0, len(all))
+	for _, space := range all {
+		descriptions = append(descriptions, fmt.Sprintf("%s (ID: %s, slug: %s)", space.Name, space.GetID(), space.Slug))
+	}
+	sort.Strings(descriptions)
+
+	return strings.Join(descriptions, ", ")
+}
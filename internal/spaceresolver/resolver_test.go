@@ -0,0 +1,92 @@
+package spaceresolver
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSpace(id string, name string, slug string) *spaces.Space {
+	space := spaces.NewSpace(name)
+	space.ID = id
+	space.Slug = slug
+	return space
+}
+
+func newTestResolver(all ...*spaces.Space) *Resolver {
+	return &Resolver{
+		list: func() ([]*spaces.Space, error) {
+			return all, nil
+		},
+	}
+}
+
+func TestResolveAcceptsIDNameAndSlug(t *testing.T) {
+	resolver := newTestResolver(
+		newTestSpace("Spaces-1", "Default", "default"),
+		newTestSpace("Spaces-2", "Platform Team", "platform-team"),
+	)
+
+	for _, reference := range []string{"Spaces-2", "Platform Team", "platform team", "platform-team"} {
+		id, err := resolver.Resolve(reference)
+		require.NoError(t, err, reference)
+		require.Equal(t, "Spaces-2", id, reference)
+	}
+}
+
+func TestResolveByNameAndSlug(t *testing.T) {
+	resolver := newTestResolver(
+		newTestSpace("Spaces-1", "Default", "default"),
+		newTestSpace("Spaces-2", "Platform Team", "platform-team"),
+	)
+
+	space, err := resolver.ResolveByName("Platform Team")
+	require.NoError(t, err)
+	require.Equal(t, "Spaces-2", space.GetID())
+
+	space, err = resolver.ResolveBySlug("default")
+	require.NoError(t, err)
+	require.Equal(t, "Spaces-1", space.GetID())
+
+	_, err = resolver.ResolveByName("platform-team")
+	require.Error(t, err)
+}
+
+func TestResolveMissingSpaceListsAvailableSpaces(t *testing.T) {
+	resolver := newTestResolver(
+		newTestSpace("Spaces-1", "Default", "default"),
+		newTestSpace("Spaces-2", "Platform Team", "platform-team"),
+	)
+
+	_, err := resolver.Resolve("Payments")
+	require.ErrorContains(t, err, `no space with name or slug "Payments" was found`)
+	require.ErrorContains(t, err, "Default (ID: Spaces-1, slug: default)")
+	require.ErrorContains(t, err, "Platform Team (ID: Spaces-2, slug: platform-team)")
+}
+
+func TestResolveAmbiguousReference(t *testing.T) {
+	resolver := newTestResolver(
+		newTestSpace("Spaces-1", "Shared", "team-a"),
+		newTestSpace("Spaces-2", "Team A", "shared"),
+	)
+
+	_, err := resolver.Resolve("shared")
+	require.ErrorContains(t, err, "matches more than one space")
+}
+
+func TestResolveListsSpacesOnce(t *testing.T) {
+	calls := 0
+	resolver := &Resolver{
+		list: func() ([]*spaces.Space, error) {
+			calls++
+			return []*spaces.Space{newTestSpace("Spaces-1", "Default", "default")}, nil
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := resolver.Resolve("Default")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,38 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TokenSource supplies the bearer token sent with every request, refreshing it as required.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// BearerTokenTransport replaces the Authorization header of each request with the current token from Source, so that
+// a token refreshed during a long apply is picked up by a client whose default headers were fixed at creation.
+type BearerTokenTransport struct {
+	Base   http.RoundTripper
+	Source TokenSource
+}
+
+func NewBearerTokenTransport(base http.RoundTripper, source TokenSource) *BearerTokenTransport {
+	return &BearerTokenTransport{
+		Base:   base,
+		Source: source,
+	}
+}
+
+func (t *BearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	authorizedReq := req.Clone(req.Context())
+	authorizedReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return t.Base.RoundTrip(authorizedReq)
+}
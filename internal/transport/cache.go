@@ -0,0 +1,169 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var spaceSegmentPattern = regexp.MustCompile(`^spaces-\d+$`)
+
+// uncachedCollections are the collections whose content changes without the provider writing to them, such as the
+// server tasks polled while a deployment runs.
+var uncachedCollections = map[string]bool{
+	"events":        true,
+	"interruptions": true,
+	"serverstatus":  true,
+	"tasks":         true,
+}
+
+// CacheTransport answers identical GET requests from a cache kept for the lifetime of the provider process, which is
+// a single plan or apply, and sends a single request for identical GETs that are in flight at the same time. Any other
+// request discards every cached response for the space it writes to, as a write can change what is read through the
+// paths of other resources, such as the releases of a project. A write outside of a space discards the whole cache.
+// Only successful responses are cached, and a request with a Cache-Control: no-cache header bypasses the cache.
+type CacheTransport struct {
+	Base http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// writes counts invalidations, so a GET that overlapped a write is not cached.
+	writes uint64
+}
+
+type cacheEntry struct {
+	path string
+	done chan struct{}
+
+	response *cachedResponse
+}
+
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func NewCacheTransport(base http.RoundTripper) *CacheTransport {
+	return &CacheTransport{
+		Base:    base,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidate(req.URL.Path)
+		resp, err := t.Base.RoundTrip(req)
+		// A GET that started before the write finished may have stored what the write replaced.
+		t.invalidate(req.URL.Path)
+		return resp, err
+	}
+
+	if !isCacheable(req) {
+		return t.Base.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+
+	t.mu.Lock()
+	if entry, ok := t.entries[key]; ok {
+		t.mu.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if entry.response != nil {
+			return entry.response.toResponse(req), nil
+		}
+		return t.Base.RoundTrip(req)
+	}
+
+	entry := &cacheEntry{path: strings.ToLower(req.URL.Path), done: make(chan struct{})}
+	t.entries[key] = entry
+	writes := t.writes
+	t.mu.Unlock()
+
+	resp, err := t.Base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusOK {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			err = readErr
+			resp = nil
+		} else {
+			entry.response = &cachedResponse{statusCode: resp.StatusCode, header: resp.Header.Clone(), body: body}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+
+	t.mu.Lock()
+	if (entry.response == nil || t.writes != writes) && t.entries[key] == entry {
+		delete(t.entries, key)
+	}
+	close(entry.done)
+	t.mu.Unlock()
+
+	return resp, err
+}
+
+// invalidate discards the cached responses a write to path may have changed.
+func (t *CacheTransport) invalidate(path string) {
+	space := spacePrefixOf(strings.ToLower(path))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.writes++
+	for key, entry := range t.entries {
+		if space == "/api" || spacePrefixOf(entry.path) == space {
+			delete(t.entries, key)
+		}
+	}
+}
+
+func isCacheable(req *http.Request) bool {
+	if strings.Contains(strings.ToLower(req.Header.Get("Cache-Control")), "no-cache") {
+		return false
+	}
+
+	segments := collectionSegments(strings.ToLower(req.URL.Path))
+	return len(segments) > 0 && !uncachedCollections[segments[0]]
+}
+
+// spacePrefixOf returns the API path prefix up to and including the space ID, if the path has one.
+func spacePrefixOf(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 1 && segments[0] == "api" && spaceSegmentPattern.MatchString(segments[1]) {
+		return "/api/" + segments[1]
+	}
+	return "/api"
+}
+
+func collectionSegments(path string) []string {
+	rest := strings.Trim(strings.TrimPrefix(path, spacePrefixOf(path)), "/")
+	if rest == "" {
+		return nil
+	}
+	return strings.Split(rest, "/")
+}
+
+func (r *cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(r.statusCode),
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newCountingServer(t *testing.T, delay time.Duration) (*httptest.Server, map[string]*int32) {
+	var mu sync.Mutex
+	counts := map[string]*int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		key := r.Method + " " + r.URL.Path
+		if counts[key] == nil {
+			counts[key] = new(int32)
+		}
+		count := atomic.AddInt32(counts[key], 1)
+		mu.Unlock()
+
+		time.Sleep(delay)
+		_, _ = io.WriteString(w, r.URL.Path+" "+string(rune('0'+count)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, counts
+}
+
+func get(t *testing.T, httpClient *http.Client, url string) string {
+	resp, err := httpClient.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func send(t *testing.T, httpClient *http.Client, method string, url string) {
+	req, err := http.NewRequest(method, url, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestCacheTransportAnswersRepeatedGets(t *testing.T) {
+	server, counts := newCountingServer(t, 0)
+	httpClient := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+	variableSet := server.URL + "/api/Spaces-1/variables/variableset-Projects-1"
+	for i := 0; i < 5; i++ {
+		require.Equal(t, "/api/Spaces-1/variables/variableset-Projects-1 1", get(t, httpClient, variableSet))
+	}
+	require.Equal(t, int32(1), *counts["GET /api/Spaces-1/variables/variableset-Projects-1"])
+}
+
+func TestCacheTransportDeduplicatesConcurrentGets(t *testing.T) {
+	server, counts := newCountingServer(t, 50*time.Millisecond)
+	httpClient := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			get(t, httpClient, server.URL+"/api/Spaces-1/projects/Projects-1")
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), *counts["GET /api/Spaces-1/projects/Projects-1"])
+}
+
+func TestCacheTransportInvalidatesOnWrites(t *testing.T) {
+	server, counts := newCountingServer(t, 0)
+	httpClient := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+	project := server.URL + "/api/Spaces-1/projects/Projects-1"
+	environment := server.URL + "/api/Spaces-1/environments/Environments-1"
+	otherSpace := server.URL + "/api/Spaces-2/environments/Environments-2"
+
+	get(t, httpClient, project)
+	get(t, httpClient, environment)
+	get(t, httpClient, otherSpace)
+
+	send(t, httpClient, http.MethodPut, project)
+
+	require.Equal(t, "/api/Spaces-1/projects/Projects-1 2", get(t, httpClient, project))
+	require.Equal(t, "/api/Spaces-1/environments/Environments-1 2", get(t, httpClient, environment))
+	require.Equal(t, "/api/Spaces-2/environments/Environments-2 1", get(t, httpClient, otherSpace))
+
+	send(t, httpClient, http.MethodPost, server.URL+"/api/spaces")
+	require.Equal(t, "/api/Spaces-2/environments/Environments-2 2", get(t, httpClient, otherSpace))
+
+	require.Equal(t, int32(2), *counts["GET /api/Spaces-1/projects/Projects-1"])
+}
+
+func TestCacheTransportInvalidatesProjectScopedReads(t *testing.T) {
+	server, _ := newCountingServer(t, 0)
+	httpClient := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+	// The project is only named in the body of the request that creates the release.
+	releases := server.URL + "/api/Spaces-1/projects/Projects-1/releases"
+	get(t, httpClient, releases)
+	send(t, httpClient, http.MethodPost, server.URL+"/api/Spaces-1/releases")
+	require.Equal(t, "/api/Spaces-1/projects/Projects-1/releases 2", get(t, httpClient, releases))
+
+	// The step is changed through the deployment process, which is read through the project.
+	process := server.URL + "/api/Spaces-1/projects/Projects-1/deploymentprocesses"
+	get(t, httpClient, process)
+	send(t, httpClient, http.MethodPut, server.URL+"/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1")
+	require.Equal(t, "/api/Spaces-1/projects/Projects-1/deploymentprocesses 2", get(t, httpClient, process))
+}
+
+func TestCacheTransportDoesNotCacheTasks(t *testing.T) {
+	server, counts := newCountingServer(t, 0)
+	httpClient := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+	task := server.URL + "/api/Spaces-1/tasks/ServerTasks-1"
+	get(t, httpClient, task)
+	get(t, httpClient, task)
+
+	require.Equal(t, int32(2), *counts["GET /api/Spaces-1/tasks/ServerTasks-1"])
+}
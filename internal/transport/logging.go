@@ -0,0 +1,225 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem HTTP traces are written to. Its level can be set on its own with
+// TF_LOG_PROVIDER_OCTOPUSDEPLOY_HTTP.
+const LogSubsystem = "http"
+
+const logLevelEnvVar = "TF_LOG_PROVIDER_OCTOPUSDEPLOY_HTTP"
+
+// redacted replaces every secret written to the trace.
+const redacted = "***"
+
+// maxLoggedBodySize is the largest body written to the trace; larger bodies are truncated.
+const maxLoggedBodySize = 64 * 1024
+
+var sensitiveHeaders = []string{"Authorization", "X-Octopus-ApiKey", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the JSON fields whose values are always redacted, matched case-insensitively. Sensitive values
+// sent as core.SensitiveValue ("NewValue") or with "IsSensitive" set are redacted wherever they appear.
+var sensitiveFields = map[string]bool{
+	"apikey":        true,
+	"password":      true,
+	"token":         true,
+	"access_token":  true,
+	"subject_token": true,
+	"id_token":      true,
+	"secretkey":     true,
+	"clientsecret":  true,
+}
+
+// tokenEndpointSuffixes end the paths of the endpoints that issue identity and access tokens: the Octopus token
+// exchange endpoint and the GitHub Actions identity token endpoint.
+var tokenEndpointSuffixes = []string{"/token/v1", "/idtoken"}
+
+// LoggingTransport writes the method, URL, status, latency and JSON bodies of every request to the http tflog
+// subsystem at TRACE level, with API keys, bearer tokens and sensitive values redacted. The bodies sent to and returned
+// by token endpoints are never written, as tokens appear in them under names such as "value". The Octopus API client
+// does not pass a context with its requests, so entries are written to the logger of the context the transport was
+// built with.
+type LoggingTransport struct {
+	Base http.RoundTripper
+	ctx  context.Context
+}
+
+func NewLoggingTransport(ctx context.Context, base http.RoundTripper) *LoggingTransport {
+	return &LoggingTransport{
+		Base: base,
+		ctx:  tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(logLevelEnvVar)),
+	}
+}
+
+// TraceEnabled reports whether Terraform has been asked for TRACE logs from the provider, which is the only time the
+// cost of buffering bodies for the trace is worth paying.
+func TraceEnabled() bool {
+	for _, name := range []string{logLevelEnvVar, "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := strings.ToUpper(os.Getenv(name)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+	return false
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             redactURL(req.URL),
+		"request_headers": redactHeaders(req.Header),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fields["request_body"] = redactBodyFor(req.URL, body)
+	}
+
+	tflog.SubsystemTrace(t.ctx, LogSubsystem, "Sending HTTP request", fields)
+
+	start := time.Now()
+	resp, err := t.Base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(t.ctx, LogSubsystem, "HTTP request failed", fields)
+		return resp, err
+	}
+
+	delete(fields, "request_headers")
+	delete(fields, "request_body")
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header)
+
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		fields["response_body"] = redactBodyFor(req.URL, body)
+	}
+
+	tflog.SubsystemTrace(t.ctx, LogSubsystem, "Received HTTP response", fields)
+
+	return resp, nil
+}
+
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	redactedURL.User = nil
+
+	query := redactedURL.Query()
+	for name := range query {
+		if sensitiveFields[strings.ToLower(name)] {
+			query.Set(name, redacted)
+		}
+	}
+	redactedURL.RawQuery = query.Encode()
+
+	return redactedURL.String()
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	redactedHeaders := make(map[string]string, len(headers))
+	for name, values := range headers {
+		redactedHeaders[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range sensitiveHeaders {
+		if headers.Get(name) != "" {
+			redactedHeaders[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+
+	return redactedHeaders
+}
+
+// redactBodyFor returns the body of a request to u, or of its response, for the trace.
+func redactBodyFor(u *url.URL, body []byte) string {
+	if len(body) > 0 && isTokenEndpoint(u) {
+		return fmt.Sprintf("(%d byte token body omitted)", len(body))
+	}
+	return redactBody(body)
+}
+
+// isTokenEndpoint reports whether u issues identity or access tokens. Identity token requests are recognised by their
+// audience parameter, as the path of the token request URL is chosen by the CI system.
+func isTokenEndpoint(u *url.URL) bool {
+	path := strings.ToLower(u.Path)
+	for _, suffix := range tokenEndpointSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return u.Query().Has("audience")
+}
+
+// redactBody returns body for the trace with every sensitive value replaced. Bodies that are not JSON are summarised
+// by their size rather than written out, as their content cannot be inspected for secrets.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Sprintf("(%d byte non-JSON body omitted)", len(body))
+	}
+
+	redactedBody, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return fmt.Sprintf("(%d byte body omitted)", len(body))
+	}
+
+	if len(redactedBody) > maxLoggedBodySize {
+		return string(redactedBody[:maxLoggedBodySize]) + "... (truncated)"
+	}
+
+	return string(redactedBody)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		isSensitive, _ := v["IsSensitive"].(bool)
+		for key, field := range v {
+			switch {
+			case field == nil:
+			case sensitiveFields[strings.ToLower(key)], key == "NewValue", isSensitive && key == "Value":
+				v[key] = redacted
+			default:
+				v[key] = redactValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	}
+
+	return value
+}
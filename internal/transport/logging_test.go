@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingTransportRedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Contains(t, string(body), "account-password")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"Accounts-1","ApiKey":"API-RESPONSEKEY","Token":{"HasValue":true,"NewValue":null}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	httpClient := &http.Client{Transport: NewLoggingTransport(ctx, http.DefaultTransport)}
+
+	requestBody := `{"Name":"Account","Password":{"HasValue":true,"NewValue":"account-password"},` +
+		`"Variables":[{"Name":"Secret","IsSensitive":true,"Value":"variable-secret"},{"Name":"Plain","IsSensitive":false,"Value":"plain-value"}],` +
+		`"Properties":{"Octopus.Action.Script.ScriptBody":{"HasValue":true,"NewValue":"property-secret"}}}`
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/accounts?apikey=API-QUERYKEY", strings.NewReader(requestBody))
+	require.NoError(t, err)
+	req.Header.Set("X-Octopus-ApiKey", "API-HEADERKEY")
+	req.Header.Set("Authorization", "Bearer bearer-token")
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(responseBody), "API-RESPONSEKEY")
+
+	logs := output.String()
+	for _, secret := range []string{"API-HEADERKEY", "bearer-token", "API-QUERYKEY", "account-password", "variable-secret", "property-secret", "API-RESPONSEKEY"} {
+		require.NotContains(t, logs, secret)
+	}
+	require.Contains(t, logs, "plain-value")
+	require.Contains(t, logs, `"@module":"provider.http"`)
+	require.Contains(t, logs, `"status":200`)
+	require.Contains(t, logs, `"method":"POST"`)
+	require.Contains(t, logs, "duration_ms")
+}
+
+func TestLoggingTransportOmitsTokenBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/token/v1") {
+			_, _ = w.Write([]byte(`{"access_token":"exchanged-access-token","expires_in":3600}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"value":"github-identity-token"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	httpClient := &http.Client{Transport: NewLoggingTransport(ctx, http.DefaultTransport)}
+
+	for _, req := range []func() (*http.Request, error){
+		func() (*http.Request, error) {
+			return http.NewRequest(http.MethodGet, server.URL+"/_apis/distributedtask/idtoken?api-version=2.0&audience=service-account", nil)
+		},
+		func() (*http.Request, error) {
+			return http.NewRequest(http.MethodGet, server.URL+"/identity?audience=service-account", nil)
+		},
+		func() (*http.Request, error) {
+			return http.NewRequest(http.MethodPost, server.URL+"/token/v1", strings.NewReader(`{"subject_token":"github-identity-token","audience":"service-account"}`))
+		},
+	} {
+		request, err := req()
+		require.NoError(t, err)
+		resp, err := httpClient.Do(request)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	logs := output.String()
+	require.NotContains(t, logs, "github-identity-token")
+	require.NotContains(t, logs, "exchanged-access-token")
+	require.Contains(t, logs, "byte token body omitted")
+}
+
+func TestLoggingTransportOmitsNonJSONBodies(t *testing.T) {
+	require.Equal(t, "(9 byte non-JSON body omitted)", redactBody([]byte("plaintext")))
+	require.Equal(t, "", redactBody(nil))
+}
+
+func TestTraceEnabled(t *testing.T) {
+	t.Setenv("TF_LOG", "")
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv(logLevelEnvVar, "")
+	require.False(t, TraceEnabled())
+
+	t.Setenv("TF_LOG", "trace")
+	require.True(t, TraceEnabled())
+
+	t.Setenv(logLevelEnvVar, "DEBUG")
+	require.False(t, TraceEnabled())
+}
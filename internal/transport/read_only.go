@@ -0,0 +1,31 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyTransport refuses to send any request that could change the Octopus Server. It backs up the read-only
+// provider mode, which refuses changes to resources before they reach the client.
+type ReadOnlyTransport struct {
+	Base http.RoundTripper
+}
+
+func NewReadOnlyTransport(base http.RoundTripper) *ReadOnlyTransport {
+	return &ReadOnlyTransport{
+		Base: base,
+	}
+}
+
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.Base.RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	return nil, fmt.Errorf("the provider is configured as read only, so the %s request to %s was not sent", req.Method, req.URL.Redacted())
+}
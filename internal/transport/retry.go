@@ -0,0 +1,179 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryTransport retries requests that failed with a transient error, waiting with exponential backoff between attempts.
+//
+// Idempotent requests are retried on connection errors and on 502, 503 and 504 responses. Every request is retried on a
+// 429 response, because a rate-limited request was never processed by the server. A Retry-After header takes
+// precedence over the computed backoff, but the wait never exceeds RetryWaitMax.
+type RetryTransport struct {
+	Base         http.RoundTripper
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+func NewRetryTransport(base http.RoundTripper, maxRetries int, retryWaitMin time.Duration, retryWaitMax time.Duration) *RetryTransport {
+	if retryWaitMax < retryWaitMin {
+		retryWaitMax = retryWaitMin
+	}
+
+	return &RetryTransport{
+		Base:         base,
+		MaxRetries:   maxRetries,
+		RetryWaitMin: retryWaitMin,
+		RetryWaitMax: retryWaitMax,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.MaxRetries <= 0 {
+		return t.Base.RoundTrip(req)
+	}
+
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(attemptReq)
+
+		if attempt >= t.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned %s; retrying in %s (attempt %d of %d)", req.Method, req.URL.Redacted(), resp.Status, wait, attempt+1, t.MaxRetries)
+			drainBody(resp)
+		} else {
+			log.Printf("[DEBUG] %s %s failed with %s; retrying in %s (attempt %d of %d)", req.Method, req.URL.Redacted(), err, wait, attempt+1, t.MaxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.RetryWaitMax)
+		}
+	}
+
+	wait := float64(t.RetryWaitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(t.RetryWaitMax) {
+		return t.RetryWaitMax
+	}
+
+	return time.Duration(wait)
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// parseRetryAfter reads a Retry-After header expressed either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// rewindableBody returns a function that produces a fresh copy of the request body for every attempt.
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	getBody := func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	req.Body, _ = getBody()
+	req.GetBody = getBody
+
+	return getBody, nil
+}
+
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,133 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryClient(maxRetries int) *http.Client {
+	return &http.Client{Transport: NewRetryTransport(http.DefaultTransport, maxRetries, time.Millisecond, 5*time.Millisecond)}
+}
+
+func TestRetryTransportRetriesServiceUnavailable(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	resp, err := newTestRetryClient(3).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportStopsAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	resp, err := newTestRetryClient(2).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportDoesNotRetryPostOnServiceUnavailable(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	resp, err := newTestRetryClient(3).Post(server.URL, "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportRetriesPostOnTooManyRequestsWithBody(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, `{"Name":"test"}`, string(body))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	resp, err := newTestRetryClient(3).Post(server.URL, "application/json", io.NopCloser(strings.NewReader(`{"Name":"test"}`)))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportDisabledWithZeroRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := newTestRetryClient(0).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	retry := NewRetryTransport(http.DefaultTransport, 5, time.Second, 5*time.Second)
+
+	require.Equal(t, time.Second, retry.backoff(0, nil))
+	require.Equal(t, 2*time.Second, retry.backoff(1, nil))
+	require.Equal(t, 4*time.Second, retry.backoff(2, nil))
+	require.Equal(t, 5*time.Second, retry.backoff(3, nil))
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	require.Equal(t, 3*time.Second, retry.backoff(0, resp))
+}
+
+func TestRetryTransportBackoffCapsRetryAfter(t *testing.T) {
+	retry := NewRetryTransport(http.DefaultTransport, 5, time.Second, 5*time.Second)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"12"}}}
+	require.Equal(t, 5*time.Second, retry.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(3*time.Hour).UTC().Format(http.TimeFormat))
+	require.Equal(t, 5*time.Second, retry.backoff(0, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("7")
+	require.True(t, ok)
+	require.Equal(t, 7*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("")
+	require.False(t, ok)
+}
@@ -0,0 +1,78 @@
+package transport
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ThrottleTransport limits the requests sent to the Octopus Server, so that a large apply queues rather than
+// overwhelming a small instance. At most MaxConcurrent requests are in flight at once, and requests are spaced so that
+// no more than RequestsPerSecond are started each second. A limit of zero is no limit.
+type ThrottleTransport struct {
+	Base http.RoundTripper
+
+	slots    chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func NewThrottleTransport(base http.RoundTripper, maxConcurrent int, requestsPerSecond int) *ThrottleTransport {
+	t := &ThrottleTransport{
+		Base: base,
+	}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		t.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+
+	return t
+}
+
+func (t *ThrottleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-t.slots }()
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	return t.Base.RoundTrip(req)
+}
+
+// reserve claims the next start time available to a request, returning how long to wait for it.
+func (t *ThrottleTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+
+	return wait
+}
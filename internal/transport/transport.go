@@ -0,0 +1,155 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// Options describes how the HTTP client handed to the Octopus API client is built.
+type Options struct {
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	CACertificateFile  string
+	CACertificatePEM   string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+	ProxyURL           string
+	RequestTimeout     time.Duration
+	ReadOnly           bool
+	CacheReads         bool
+
+	MaxConcurrentRequests int
+	RequestsPerSecond     int
+
+	TokenSource TokenSource
+
+	// LogContext carries the logger HTTP traces are written to. Requests are only traced when it is set and TRACE
+	// logging is enabled.
+	LogContext context.Context
+}
+
+// NewHTTPClient builds the HTTP client shared by the SDKv2 and framework providers.
+func NewHTTPClient(options Options) (*http.Client, error) {
+	baseTransport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := newTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+	baseTransport.TLSClientConfig = tlsConfig
+
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		baseTransport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var roundTripper http.RoundTripper = baseTransport
+
+	if options.LogContext != nil && TraceEnabled() {
+		roundTripper = NewLoggingTransport(options.LogContext, roundTripper)
+	}
+
+	if options.MaxConcurrentRequests > 0 || options.RequestsPerSecond > 0 {
+		roundTripper = NewThrottleTransport(roundTripper, options.MaxConcurrentRequests, options.RequestsPerSecond)
+	}
+	if options.TokenSource != nil {
+		roundTripper = NewBearerTokenTransport(roundTripper, options.TokenSource)
+	}
+	roundTripper = NewRetryTransport(roundTripper, options.MaxRetries, options.RetryWaitMin, options.RetryWaitMax)
+	if options.CacheReads {
+		roundTripper = NewCacheTransport(roundTripper)
+	}
+	if options.ReadOnly {
+		roundTripper = NewReadOnlyTransport(roundTripper)
+	}
+
+	return &http.Client{
+		Transport: roundTripper,
+		Timeout:   options.RequestTimeout,
+	}, nil
+}
+
+func newTLSConfig(options Options) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if options.CACertificateFile != "" || options.CACertificatePEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if options.CACertificateFile != "" {
+			content, err := os.ReadFile(options.CACertificateFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(content) {
+				return nil, fmt.Errorf("no PEM-encoded certificates found in CA certificate file %s", options.CACertificateFile)
+			}
+		}
+
+		if options.CACertificatePEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(options.CACertificatePEM)) {
+				return nil, fmt.Errorf("no PEM-encoded certificates found in CA certificate PEM")
+			}
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if options.ClientCertificate != "" || options.ClientKey != "" {
+		if options.ClientCertificate == "" || options.ClientKey == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mutual TLS")
+		}
+
+		certificate, err := readPEMOrFile(options.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+
+		key, err := readPEMOrFile(options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client key: %w", err)
+		}
+
+		keyPair, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEMOrFile returns value when it is PEM-encoded content, otherwise the content of the file it points to.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
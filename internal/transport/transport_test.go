@@ -0,0 +1,138 @@
+package transport
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(caPEM)
+}
+
+func TestNewHTTPClientRejectsUnknownCertificateAuthority(t *testing.T) {
+	server, _ := newTestTLSServer(t)
+
+	httpClient, err := NewHTTPClient(Options{})
+	require.NoError(t, err)
+
+	_, err = httpClient.Get(server.URL)
+	require.Error(t, err)
+}
+
+func TestNewHTTPClientTrustsCACertificatePEM(t *testing.T) {
+	server, caPEM := newTestTLSServer(t)
+
+	httpClient, err := NewHTTPClient(Options{CACertificatePEM: caPEM})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewHTTPClientTrustsCACertificateFile(t *testing.T) {
+	server, caPEM := newTestTLSServer(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(caPEM), 0600))
+
+	httpClient, err := NewHTTPClient(Options{CACertificateFile: caFile})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewHTTPClientInsecureSkipVerify(t *testing.T) {
+	server, _ := newTestTLSServer(t)
+
+	httpClient, err := NewHTTPClient(Options{InsecureSkipVerify: true})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewHTTPClientInvalidOptions(t *testing.T) {
+	_, err := NewHTTPClient(Options{CACertificatePEM: "not a certificate"})
+	require.Error(t, err)
+
+	_, err = NewHTTPClient(Options{ClientCertificate: "-----BEGIN CERTIFICATE-----"})
+	require.Error(t, err)
+
+	_, err = NewHTTPClient(Options{ProxyURL: "http://[::1"})
+	require.Error(t, err)
+}
+
+func TestNewHTTPClientRequestTimeout(t *testing.T) {
+	httpClient, err := NewHTTPClient(Options{RequestTimeout: 5 * time.Second})
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, httpClient.Timeout)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+func TestNewHTTPClientSetsBearerTokenFromTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer refreshed-token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Options{TokenSource: staticTokenSource("refreshed-token")})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer initial-token")
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewHTTPClientReadOnlyRefusesWrites(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Options{ReadOnly: true, MaxRetries: 3})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch} {
+		req, err := http.NewRequest(method, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = httpClient.Do(req)
+		require.ErrorContains(t, err, "read only")
+	}
+
+	require.Equal(t, 1, requests)
+}
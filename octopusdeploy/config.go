@@ -1,80 +1,52 @@
 package octopusdeploy
 
 import (
-	"fmt"
-	"net/url"
+	"context"
+	"errors"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
-// Config holds Address and the APIKey of the Octopus Deploy server
+// Config is the meta handed to SDKv2 resources and data sources. The client, server version and feature toggles it
+// exposes are shared with the framework provider.
 type Config struct {
-	Address     string
-	APIKey      string
-	AccessToken string
-	SpaceID     string
+	*providerconfig.Config
 }
 
-// Client returns a new Octopus Deploy client
-func (c *Config) Client() (*client.Client, diag.Diagnostics) {
-	octopus, err := getClientForDefaultSpace(c)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	if len(c.SpaceID) > 0 {
-		space, err := spaces.GetByID(octopus, c.SpaceID)
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
+// newConfig returns the configuration shared with the framework provider, loading it if neither provider has yet.
+func newConfig(ctx context.Context, settings providerconfig.Settings) (*Config, diag.Diagnostics) {
+	config := &Config{Config: providerconfig.Shared(settings)}
 
-		octopus, err = getClientForSpace(c, space.GetID())
-		if err != nil {
-			return nil, diag.FromErr(err)
+	if err := config.Load(ctx); err != nil {
+		var loadError *providerconfig.LoadError
+		if errors.As(err, &loadError) {
+			return nil, diag.Diagnostics{{Severity: diag.Error, Summary: loadError.Summary, Detail: loadError.Err.Error()}}
 		}
+		return nil, diag.FromErr(err)
 	}
 
-	return octopus, nil
-}
-
-func getClientForDefaultSpace(c *Config) (*client.Client, error) {
-	return getClientForSpace(c, "")
+	return config, nil
 }
 
-func getClientForSpace(c *Config, spaceID string) (*client.Client, error) {
-	apiURL, err := url.Parse(c.Address)
-	if err != nil {
-		return nil, err
+// EnsureResourceCompatibilityByFeature Reports whether resource is compatible with current instance of Octopus Server by feature toggle.
+// Returns diagnostics with error when resource is incompatible and empty diagnostics for compatible resources
+func (c *Config) EnsureResourceCompatibilityByFeature(resourceName string, toggle string) diag.Diagnostics {
+	if incompatibility := c.CheckResourceCompatibilityByFeature(resourceName, toggle); incompatibility != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: incompatibility.Summary, Detail: incompatibility.Detail}}
 	}
 
-	credential, err := getApiCredential(c)
-	if err != nil {
-		return nil, err
-	}
-
-	return client.NewClientWithCredentials(nil, apiURL, credential, spaceID, "TerraformProvider")
+	return nil
 }
 
-func getApiCredential(c *Config) (client.ICredential, error) {
-	if c.APIKey != "" {
-		credential, err := client.NewApiKey(c.APIKey)
-		if err != nil {
-			return nil, err
-		}
-
-		return credential, nil
-	}
-
-	if c.AccessToken != "" {
-		credential, err := client.NewAccessToken(c.AccessToken)
-		if err != nil {
-			return nil, err
-		}
-
-		return credential, nil
+// EnsureResourceCompatibilityByVersion Reports whether resource is compatible with current version of Octopus Server.
+// Returns diagnostics with error when resource is incompatible and empty diagnostics for compatible resources
+//
+// Example: '2025.1' - first version where resource can be used
+func (c *Config) EnsureResourceCompatibilityByVersion(resourceName string, version string) diag.Diagnostics {
+	if incompatibility := c.CheckResourceCompatibilityByVersion(resourceName, version); incompatibility != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: incompatibility.Summary, Detail: incompatibility.Detail}}
 	}
 
-	return nil, fmt.Errorf("either an APIKey or an AccessToken is required to connect to the Octopus Server instance")
+	return nil
 }
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,7 +27,7 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	spaceID := d.Get("space_id").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	existingAccounts, err := accounts.Get(client, spaceID, &query)
 	if err != nil {
 		return diag.FromErr(err)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,7 +36,7 @@ func dataSourceAzureServiceFabricClusterDeploymentTargetsRead(ctx context.Contex
 		Thumbprint:          d.Get("thumbprint").(string),
 	}
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	existingDeploymentTargets, err := machines.Get(client, d.Get("space_id").(string), query)
 	if err != nil {
 		return diag.FromErr(err)
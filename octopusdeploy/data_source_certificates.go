@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,7 +31,7 @@ func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, m i
 	}
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	existingCertificates, err := certificates.Get(client, spaceID, query)
 	if err != nil {
 		return diag.FromErr(err)
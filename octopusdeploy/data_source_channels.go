@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -29,7 +28,7 @@ func dataSourceChannelsRead(ctx context.Context, d *schema.ResourceData, m inter
 	projectID := d.Get("project_id").(string)
 	spaceID := d.Get("space_id").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	var existingChannels *resources.Resources[*channels.Channel] = nil
 	var err error
@@ -2,7 +2,6 @@ package octopusdeploy
 
 import (
 	"context"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,7 +30,7 @@ func dataSourceKubernetesAgentWorkersRead(ctx context.Context, d *schema.Resourc
 		Thumbprint:          d.Get("thumbprint").(string),
 	}
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	existingWorkers, err := client.Workers.Get(query)
 	if err != nil {
 		return diag.FromErr(err)
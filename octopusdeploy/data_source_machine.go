@@ -3,7 +3,6 @@ package octopusdeploy
 import (
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -107,7 +106,7 @@ func dataSourceMachine() *schema.Resource {
 }
 
 func dataMachineReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	machineName := d.Get("name").(string)
 	existingMachines, err := client.Machines.GetByName(machineName)
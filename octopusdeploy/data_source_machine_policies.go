@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machinepolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,7 +26,7 @@ func dataSourceMachinePoliciesRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	existingMachinePolicies, err := machinepolicies.Get(client, spaceID, query)
 	if err != nil {
 		return diag.FromErr(err)
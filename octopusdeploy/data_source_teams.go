@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, meta inter
 		Take:          d.Get("take").(int),
 	}
 
-	client := meta.(*client.Client)
+	client := meta.(*Config).Client
 	existingTeams, err := client.Teams.Get(query)
 	if err != nil {
 		return diag.FromErr(err)
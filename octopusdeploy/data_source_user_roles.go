@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func dataSourceUserRolesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	spaceID := d.Get("space_id").(string)
 
-	client := meta.(*client.Client)
+	client := meta.(*Config).Client
 	existingUserRoles, err := userroles.Get(client, spaceID, query)
 	if err != nil {
 		return diag.FromErr(err)
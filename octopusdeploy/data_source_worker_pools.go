@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,7 +26,7 @@ func dataSourceWorkerPoolsRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 	name := d.Get("name").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	workerPools, err := workerpools.Get(client, d.Get("space_id").(string), query)
 	if err != nil {
 		return diag.FromErr(err)
@@ -2,13 +2,19 @@ package octopusdeploy
 
 import (
 	"context"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidc"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/transport"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider is the plugin entry point for the Terraform provider for Octopus Deploy.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
 			"octopusdeploy_accounts":                                        dataSourceAccounts(),
 			"octopusdeploy_azure_cloud_service_deployment_targets":          dataSourceAzureCloudServiceDeploymentTargets(),
@@ -42,7 +48,6 @@ func Provider() *schema.Provider {
 			"octopusdeploy_azure_web_app_deployment_target":                resourceAzureWebAppDeploymentTarget(),
 			"octopusdeploy_channel":                                        resourceChannel(),
 			"octopusdeploy_cloud_region_deployment_target":                 resourceCloudRegionDeploymentTarget(),
-			"octopusdeploy_deployment_process":                             resourceDeploymentProcess(),
 			"octopusdeploy_dynamic_worker_pool":                            resourceDynamicWorkerPool(),
 			"octopusdeploy_gcp_account":                                    resourceGoogleCloudPlatformAccount(),
 			"octopusdeploy_kubernetes_agent_deployment_target":             resourceKubernetesAgentDeploymentTarget(),
@@ -56,7 +61,6 @@ func Provider() *schema.Provider {
 			"octopusdeploy_project_deployment_target_trigger":              resourceProjectDeploymentTargetTrigger(),
 			"octopusdeploy_external_feed_create_release_trigger":           resourceExternalFeedCreateReleaseTrigger(),
 			"octopusdeploy_project_scheduled_trigger":                      resourceProjectScheduledTrigger(),
-			"octopusdeploy_runbook_process":                                resourceRunbookProcess(),
 			"octopusdeploy_scoped_user_role":                               resourceScopedUserRole(),
 			"octopusdeploy_ssh_connection_deployment_target":               resourceSSHConnectionDeploymentTarget(),
 			"octopusdeploy_ssh_key_account":                                resourceSSHKeyAccount(),
@@ -84,26 +88,236 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"oidc": {
+				Description: "Exchange an identity token issued by a CI system, such as GitHub Actions or GitLab, for a short-lived access token of an Octopus service account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_id": {
+							Description: "The ID of the service account whose OIDC identity trusts the identity token",
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						"audience": {
+							Description: "The audience requested from the token request URL. Defaults to the service account ID",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"identity_token": {
+							Description: "The identity token to exchange",
+							Optional:    true,
+							Sensitive:   true,
+							Type:        schema.TypeString,
+						},
+						"identity_token_file": {
+							Description: "The path to a file containing the identity token to exchange",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"identity_token_env_var": {
+							Description: "The name of the environment variable containing the identity token to exchange, such as a GitLab `id_tokens` variable",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"token_request_url": {
+							Description: "The URL from which a new identity token is requested. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_URL` environment variable set by GitHub Actions when no other identity token source is configured",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"token_request_token": {
+							Description: "The bearer token used to call the token request URL. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variable set by GitHub Actions",
+							Optional:    true,
+							Sensitive:   true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
 			"space_id": {
 				Description: "The space ID to target",
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"space_name": {
+				ConflictsWith: []string{"space_id", "space_slug"},
+				Description:   "The name of the space to target, resolved to its space ID when the provider is configured. Conflicts with `space_id` and `space_slug`",
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			"space_slug": {
+				ConflictsWith: []string{"space_id", "space_name"},
+				Description:   "The slug of the space to target, resolved to its space ID when the provider is configured. Conflicts with `space_id` and `space_name`",
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			"max_retries": {
+				Default:          transport.DefaultMaxRetries,
+				Description:      "The maximum number of times a request to the Octopus REST API is retried after a transient failure. Set to 0 to disable retries",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"max_concurrent_requests": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_MAX_CONCURRENT_REQUESTS", nil),
+				Description:      "The maximum number of requests sent to the Octopus REST API at the same time. Further requests wait for one to complete. Defaults to no limit",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"requests_per_second": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_REQUESTS_PER_SECOND", nil),
+				Description:      "The maximum number of requests sent to the Octopus REST API each second. Further requests wait their turn. Defaults to no limit",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"retry_wait_min": {
+				Default:          int(transport.DefaultRetryWaitMin.Seconds()),
+				Description:      "The minimum time, in seconds, to wait before retrying a failed request to the Octopus REST API",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"retry_wait_max": {
+				Default:          int(transport.DefaultRetryWaitMax.Seconds()),
+				Description:      "The maximum time, in seconds, to wait before retrying a failed request to the Octopus REST API",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"cache_reads": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CACHE_READS", nil),
+				Description: "When true, identical GET requests to the Octopus REST API are sent once per plan or apply and answered from a cache afterwards. Cached responses are discarded when the provider writes to the same resource",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"ca_certificate_file": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CA_CERTIFICATE_FILE", nil),
+				Description: "Path to a PEM-encoded CA certificate bundle used to verify the Octopus Server certificate",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ca_certificate_pem": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CA_CERTIFICATE_PEM", nil),
+				Description: "PEM-encoded CA certificate bundle used to verify the Octopus Server certificate",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"client_certificate": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CLIENT_CERTIFICATE", nil),
+				Description: "PEM-encoded client certificate, or the path to one, presented to the Octopus Server for mutual TLS",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"client_key": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CLIENT_KEY", nil),
+				Description: "PEM-encoded private key, or the path to one, for the client certificate presented to the Octopus Server for mutual TLS",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"insecure_skip_verify": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_INSECURE_SKIP_VERIFY", nil),
+				Description: "Skip verification of the Octopus Server TLS certificate. This should only be used for testing",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"proxy_url": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_PROXY_URL", nil),
+				Description: "The URL of the HTTP proxy used to reach the Octopus REST API. Defaults to the proxy configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"read_only": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_READ_ONLY", nil),
+				Description: "When true, the provider refuses to create, update or delete resources, and only sends read requests to the Octopus REST API. Reads, imports and data sources are unaffected",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"required_feature_toggles": {
+				Description: "Feature toggles that must be enabled on the Octopus Server. Configuring the provider fails if any is not enabled",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Type:        schema.TypeList,
+			},
+			"required_server_version": {
+				Description: "A constraint the version of the Octopus Server must satisfy, such as `>= 2024.3`. Several constraints can be separated by commas. Configuring the provider fails if the server does not satisfy it",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"request_timeout": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_REQUEST_TIMEOUT", nil),
+				Description:      "The time, in seconds, after which a request to the Octopus REST API, including any retries, is abandoned. Defaults to no timeout",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
 		},
 
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	wrapResources(provider)
+
+	return provider
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	config := Config{
-		AccessToken: d.Get("access_token").(string),
-		Address:     d.Get("address").(string),
-		APIKey:      d.Get("api_key").(string),
+	return newConfig(ctx, expandProviderSettings(d))
+}
+
+// expandProviderSettings reads the provider attributes shared with the framework provider.
+func expandProviderSettings(d *schema.ResourceData) providerconfig.Settings {
+	settings := providerconfig.Settings{
+		AccessToken:  d.Get("access_token").(string),
+		Address:      d.Get("address").(string),
+		APIKey:       d.Get("api_key").(string),
+		MaxRetries:   d.Get("max_retries").(int),
+		RetryWaitMin: time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+		RetryWaitMax: time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+
+		CACertificateFile:  d.Get("ca_certificate_file").(string),
+		CACertificatePEM:   d.Get("ca_certificate_pem").(string),
+		ClientCertificate:  d.Get("client_certificate").(string),
+		ClientKey:          d.Get("client_key").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ProxyURL:           d.Get("proxy_url").(string),
+		ReadOnly:           d.Get("read_only").(bool),
+		CacheReads:         d.Get("cache_reads").(bool),
+		RequestTimeout:     time.Duration(d.Get("request_timeout").(int)) * time.Second,
+
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(int),
+
+		RequiredServerVersion:  d.Get("required_server_version").(string),
+		RequiredFeatureToggles: expandArray(d.Get("required_feature_toggles").([]interface{})),
 	}
 	if spaceID, ok := d.GetOk("space_id"); ok {
-		config.SpaceID = spaceID.(string)
+		settings.SpaceID = spaceID.(string)
+	}
+	if spaceName, ok := d.GetOk("space_name"); ok {
+		settings.SpaceName = spaceName.(string)
+	}
+	if spaceSlug, ok := d.GetOk("space_slug"); ok {
+		settings.SpaceSlug = spaceSlug.(string)
+	}
+	if v, ok := d.GetOk("oidc"); ok {
+		settings.OIDC = expandOidcOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	return config.Client()
+	return settings
+}
+
+func expandOidcOptions(flattened map[string]interface{}) *oidc.Options {
+	return &oidc.Options{
+		ServiceAccountID:    flattened["service_account_id"].(string),
+		Audience:            flattened["audience"].(string),
+		IdentityToken:       flattened["identity_token"].(string),
+		IdentityTokenFile:   flattened["identity_token_file"].(string),
+		IdentityTokenEnvVar: flattened["identity_token_env_var"].(string),
+		TokenRequestURL:     flattened["token_request_url"].(string),
+		TokenRequestToken:   flattened["token_request_token"].(string),
+	}
 }
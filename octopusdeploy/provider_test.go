@@ -3,16 +3,23 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"math/big"
 	"os"
 	"testing"
 
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -35,6 +42,38 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderSchemasMatchAcrossMuxedProviders(t *testing.T) {
+	server, err := ProtoV6ProviderFactories()["octopusdeploy"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
+
+func TestProviderRejectsMoreThanOneOidcBlock(t *testing.T) {
+	oidcBlock := map[string]interface{}{"service_account_id": "ServiceAccounts-1"}
+
+	diags := Provider().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"oidc": []interface{}{oidcBlock},
+	}))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	diags = Provider().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"oidc": []interface{}{oidcBlock, oidcBlock},
+	}))
+	require.True(t, diags.HasError())
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("OCTOPUS_URL"); isEmpty(v) {
 		t.Fatal("OCTOPUS_URL must be set for acceptance tests")
@@ -70,3 +109,37 @@ func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, err
 		},
 	}
 }
+
+func TestMuxedProvidersShareConfig(t *testing.T) {
+	ctx := context.Background()
+	attributes := map[string]interface{}{
+		"address":     "http://127.0.0.1:1",
+		"api_key":     "API-SHAREDCONFIG",
+		"space_id":    "Spaces-1",
+		"max_retries": 0,
+	}
+
+	sdkSettings := expandProviderSettings(schema.TestResourceDataRaw(t, Provider().Schema, attributes))
+
+	frameworkProvider := octopusdeploy_framework.NewOctopusDeployFrameworkProvider()
+	schemaResp := &provider.SchemaResponse{}
+	frameworkProvider.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["address"] = tftypes.NewValue(tftypes.String, attributes["address"])
+	values["api_key"] = tftypes.NewValue(tftypes.String, attributes["api_key"])
+	values["space_id"] = tftypes.NewValue(tftypes.String, attributes["space_id"])
+	values["max_retries"] = tftypes.NewValue(tftypes.Number, big.NewFloat(0))
+
+	configureResp := &provider.ConfigureResponse{}
+	frameworkProvider.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, configureResp)
+	frameworkConfig, ok := configureResp.ResourceData.(*octopusdeploy_framework.Config)
+	require.True(t, ok)
+
+	require.Same(t, providerconfig.Shared(sdkSettings), frameworkConfig.Config)
+}
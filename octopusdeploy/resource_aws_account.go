@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceAmazonWebServicesAccountCreate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] creating AWS account")
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdAccount, err := accounts.Add(client, account)
 
 	if err != nil {
@@ -48,7 +47,7 @@ func resourceAmazonWebServicesAccountCreate(ctx context.Context, d *schema.Resou
 func resourceAmazonWebServicesAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting AWS account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,7 +61,7 @@ func resourceAmazonWebServicesAccountDelete(ctx context.Context, d *schema.Resou
 func resourceAmazonWebServicesAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading AWS account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	accountResource, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "AWS account")
@@ -82,7 +81,7 @@ func resourceAmazonWebServicesAccountUpdate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] updating AWS account: %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedAccount, err := client.Accounts.Update(account)
 	if err != nil {
 		return diag.FromErr(err)
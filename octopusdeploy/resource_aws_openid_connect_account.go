@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceAmazonWebServicesOpenIDConnectAccountCreate(ctx context.Context, d
 
 	log.Printf("[INFO] creating AWS OIDC account")
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdAccount, err := accounts.Add(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAmazonWebServicesOpenIDConnectAccountCreate(ctx context.Context, d
 func resourceAmazonWebServicesOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting AWS OIDC account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAmazonWebServicesOpenIDConnectAccountDelete(ctx context.Context, d
 func resourceAmazonWebServicesOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading AWS OIDC account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	accountResource, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "AWS OIDC account")
@@ -81,7 +80,7 @@ func resourceAmazonWebServicesOpenIDConnectAccountUpdate(ctx context.Context, d
 
 	log.Printf("[INFO] updating AWS OIDC account: %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedAccount, err := client.Accounts.Update(account)
 	if err != nil {
 		return diag.FromErr(err)
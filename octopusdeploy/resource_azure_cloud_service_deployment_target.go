@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 
 	log.Printf("[INFO] creating Azure cloud service deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdDeploymentTarget, err := machines.Add(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 func resourceAzureCloudServiceDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure cloud service deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := machines.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAzureCloudServiceDeploymentTargetDelete(ctx context.Context, d *sch
 func resourceAzureCloudServiceDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure cloud service deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	deploymentTarget, err := machines.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure cloud service deployment target")
@@ -79,7 +78,7 @@ func resourceAzureCloudServiceDeploymentTargetUpdate(ctx context.Context, d *sch
 	log.Printf("[INFO] updating Azure cloud service deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureCloudServiceDeploymentTarget(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedDeploymentTarget, err := machines.Update(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
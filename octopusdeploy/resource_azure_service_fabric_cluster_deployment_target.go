@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 
 	log.Printf("[INFO] creating Azure service fabric cluster deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdDeploymentTarget, err := machines.Add(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 func resourceAzureServiceFabricClusterDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure service fabric cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := machines.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAzureServiceFabricClusterDeploymentTargetDelete(ctx context.Context
 func resourceAzureServiceFabricClusterDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure service fabric cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	deploymentTarget, err := machines.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure service fabric cluster deployment target")
@@ -79,7 +78,7 @@ func resourceAzureServiceFabricClusterDeploymentTargetUpdate(ctx context.Context
 	log.Printf("[INFO] updating Azure service fabric cluster deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureServiceFabricClusterDeploymentTarget(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedDeploymentTarget, err := machines.Update(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
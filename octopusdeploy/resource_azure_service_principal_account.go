@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceAzureServicePrincipalAccountCreate(ctx context.Context, d *schema.R
 
 	log.Printf("[INFO] creating Azure service principal account: %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdAccount, err := accounts.Add(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAzureServicePrincipalAccountCreate(ctx context.Context, d *schema.R
 func resourceAzureServicePrincipalAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure service principal account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := accounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAzureServicePrincipalAccountDelete(ctx context.Context, d *schema.R
 func resourceAzureServicePrincipalAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure service principal account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	accountResource, err := accounts.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure service principal account")
@@ -81,7 +80,7 @@ func resourceAzureServicePrincipalAccountUpdate(ctx context.Context, d *schema.R
 
 	log.Printf("[INFO] updating Azure service principal account %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedAccount, err := accounts.Update(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceAzureSubscriptionAccountCreate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] creating Azure subscription account: %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdAccount, err := accounts.Add(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAzureSubscriptionAccountCreate(ctx context.Context, d *schema.Resou
 func resourceAzureSubscriptionAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure subscription account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := accounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAzureSubscriptionAccountDelete(ctx context.Context, d *schema.Resou
 func resourceAzureSubscriptionAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure subscription account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	accountResource, err := accounts.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure subscription account")
@@ -81,7 +80,7 @@ func resourceAzureSubscriptionAccountUpdate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] updating Azure subscription account %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedAccount, err := accounts.Update(client, account)
 	if err != nil {
 		return diag.FromErr(err)
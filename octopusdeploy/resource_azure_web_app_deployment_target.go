@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 
 	log.Printf("[INFO] creating Azure web app deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdDeploymentTarget, err := machines.Add(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 func resourceAzureWebAppDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure web app deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := machines.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceAzureWebAppDeploymentTargetDelete(ctx context.Context, d *schema.Re
 func resourceAzureWebAppDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure web app deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	deploymentTarget, err := machines.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure web app deployment target")
@@ -79,7 +78,7 @@ func resourceAzureWebAppDeploymentTargetUpdate(ctx context.Context, d *schema.Re
 	log.Printf("[INFO] updating Azure web app deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureWebAppDeploymentTarget(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedDeploymentTarget, err := machines.Update(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	log.Printf("[INFO] creating certificate: %#v", certificate)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdCertificate, err := certificates.Add(client, certificate)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +47,7 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, m in
 	log.Printf("[INFO] deleting certificate (%s)", d.Id())
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := certificates.DeleteByID(client, spaceID, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,7 +62,7 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m inte
 	log.Printf("[INFO] reading certificate (%s)", d.Id())
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	certificate, err := certificates.GetByID(client, spaceID, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "certificate")
@@ -80,7 +79,7 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m inte
 func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] updating certificate (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	certificate := expandCertificate(d)
 	certificateData := d.Get("certificate_data").(string)
 	password := d.Get("password").(string)
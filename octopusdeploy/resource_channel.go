@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -33,7 +32,7 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("creating channel: %#v", channel))
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdChannel, err := channels.Add(client, channel)
 	if err != nil {
 		return diag.FromErr(err)
@@ -55,7 +54,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("deleting channel (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := channels.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -69,7 +68,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interf
 func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading channel (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	channel, err := channels.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "channel")
@@ -90,7 +89,7 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	tflog.Info(ctx, fmt.Sprintf("updating channel (%s)", d.Id()))
 
 	channel := expandChannel(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedChannel, err := channels.Update(client, channel)
 	if err != nil {
 		return diag.FromErr(err)
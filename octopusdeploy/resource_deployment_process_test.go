@@ -118,7 +118,7 @@ func TestAccOctopusDeployDeploymentProcessWithImpliedPrimaryPackage(t *testing.T
 					resource.TestCheckResourceAttr(resourceName, "step.0.start_trigger", "StartAfterPrevious"),
 					resource.TestCheckResourceAttr(resourceName, "step.0.action.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.action_type", "Octopus.TransferPackage"),
-					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.container.#", "0"),
 					resource.TestCheckNoResourceAttr(resourceName, "step.0.action.0.channels.0"),
 					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.condition", "Success"),
 					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.environments.#", "0"),
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceDynamicWorkerPoolCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] creating dynamic worker pool: %#v", workerPool)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdWorkerPool, err := workerpools.Add(client, workerPool)
 	if err != nil {
 		return diag.FromErr(err)
@@ -49,7 +48,7 @@ func resourceDynamicWorkerPoolDelete(ctx context.Context, d *schema.ResourceData
 	log.Printf("[INFO] deleting dynamic worker pool (%s)", d.Id())
 	spaceID := d.Get("space_id").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := workerpools.DeleteByID(client, spaceID, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,7 +63,7 @@ func resourceDynamicWorkerPoolRead(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[INFO] reading dynamic worker pool (%s)", d.Id())
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	workerPoolResource, err := workerpools.GetByID(client, spaceID, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "dynamic worker pool")
@@ -84,7 +83,7 @@ func resourceDynamicWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] updating dynamic worker pool (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedWorkerPool, err := workerpools.Update(client, workerPool)
 	if err != nil {
 		return diag.FromErr(err)
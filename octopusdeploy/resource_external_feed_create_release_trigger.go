@@ -58,7 +58,7 @@ func buildExternalFeedCreateReleaseTriggerResource(d *schema.ResourceData, clien
 }
 
 func resourceExternalFeedCreateReleaseTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	projectTrigger, err := buildExternalFeedCreateReleaseTriggerResource(d, client)
 	if err != nil {
@@ -82,7 +82,7 @@ func resourceExternalFeedCreateReleaseTriggerCreate(ctx context.Context, d *sche
 func resourceExternalFeedCreateReleaseTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	projectTrigger, err := client.ProjectTriggers.GetByID(id)
 	if err != nil {
 		return diag.FromErr(err)
@@ -107,7 +107,7 @@ func resourceExternalFeedCreateReleaseTriggerRead(ctx context.Context, d *schema
 }
 
 func resourceExternalFeedCreateReleaseTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	projectTrigger, err := buildExternalFeedCreateReleaseTriggerResource(d, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -125,7 +125,7 @@ func resourceExternalFeedCreateReleaseTriggerUpdate(ctx context.Context, d *sche
 }
 
 func resourceExternalFeedCreateReleaseTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	err := client.ProjectTriggers.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
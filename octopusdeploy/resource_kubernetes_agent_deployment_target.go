@@ -2,7 +2,6 @@ package octopusdeploy
 
 import (
 	"context"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,7 +22,7 @@ func resourceKubernetesAgentDeploymentTarget() *schema.Resource {
 
 func resourceKubernetesAgentDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	deploymentTarget := expandKubernetesAgentDeploymentTarget(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdDeploymentTarget, err := machines.Add(client, deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -34,7 +33,7 @@ func resourceKubernetesAgentDeploymentTargetCreate(ctx context.Context, d *schem
 }
 
 func resourceKubernetesAgentDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	deploymentTarget, err := machines.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "kubernetes tentacle deployment target")
@@ -54,7 +53,7 @@ func resourceKubernetesAgentDeploymentTargetRead(ctx context.Context, d *schema.
 }
 
 func resourceKubernetesAgentDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := machines.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,7 +63,7 @@ func resourceKubernetesAgentDeploymentTargetDelete(ctx context.Context, d *schem
 
 func resourceKubernetesAgentDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	deploymentTarget := expandKubernetesAgentDeploymentTarget(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	deploymentTarget.ID = d.Id()
 
@@ -2,7 +2,6 @@ package octopusdeploy
 
 import (
 	"context"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workers"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,7 +22,7 @@ func resourceKubernetesAgentWorker() *schema.Resource {
 
 func resourceKubernetesAgentWorkerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	worker := expandKubernetesAgentWorker(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdWorker, err := workers.Add(client, worker)
 	if err != nil {
 		return diag.FromErr(err)
@@ -34,7 +33,7 @@ func resourceKubernetesAgentWorkerCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubernetesAgentWorkerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	Worker, err := workers.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "kubernetes tentacle worker")
@@ -54,7 +53,7 @@ func resourceKubernetesAgentWorkerRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceKubernetesAgentWorkerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := workers.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,7 +63,7 @@ func resourceKubernetesAgentWorkerDelete(ctx context.Context, d *schema.Resource
 
 func resourceKubernetesAgentWorkerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	worker := expandKubernetesAgentWorker(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	worker.ID = d.Id()
 
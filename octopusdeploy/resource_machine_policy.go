@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machinepolicies"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceMachinePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] creating machine policy: %#v", machinePolicy)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdMachinePolicy, err := machinepolicies.Add(client, machinePolicy)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +47,7 @@ func resourceMachinePolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] deleting machine policy (%s)", d.Id())
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := machinepolicies.DeleteByID(client, spaceID, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,7 +62,7 @@ func resourceMachinePolicyRead(ctx context.Context, d *schema.ResourceData, m in
 	log.Printf("[INFO] reading machine policy (%s)", d.Id())
 
 	spaceID := d.Get("space_id").(string)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	machinePolicy, err := machinepolicies.GetByID(client, spaceID, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "machine policy")
@@ -81,7 +80,7 @@ func resourceMachinePolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] updating machine policy (%s)", d.Id())
 
 	machinePolicy := expandMachinePolicy(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedMachinePolicy, err := machinepolicies.Update(client, machinePolicy)
 	if err != nil {
 		return diag.FromErr(err)
@@ -93,7 +93,7 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData, client
 }
 
 func resourceProjectDeploymentTargetTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 
 	projectTrigger, err := buildProjectDeploymentTargetTriggerResource(d, client)
 	if err != nil {
@@ -117,7 +117,7 @@ func resourceProjectDeploymentTargetTriggerCreate(ctx context.Context, d *schema
 func resourceProjectDeploymentTargetTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	resource, err := client.ProjectTriggers.GetByID(id)
 	if err != nil {
 		return diag.FromErr(err)
@@ -143,7 +143,7 @@ func resourceProjectDeploymentTargetTriggerRead(ctx context.Context, d *schema.R
 }
 
 func resourceProjectDeploymentTargetTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	projectTrigger, err := buildProjectDeploymentTargetTriggerResource(d, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -161,7 +161,7 @@ func resourceProjectDeploymentTargetTriggerUpdate(ctx context.Context, d *schema
 }
 
 func resourceProjectDeploymentTargetTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	err := client.ProjectTriggers.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -2,7 +2,6 @@ package octopusdeploy
 
 import (
 	"context"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
@@ -24,7 +23,7 @@ func resourceProjectScheduledTrigger() *schema.Resource {
 }
 
 func resourceProjectScheduledTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	spaceId := d.Get("space_id").(string)
 	spaceId = util.Ternary(len(spaceId) > 0, spaceId, client.GetSpaceID())
 
@@ -51,7 +50,7 @@ func resourceProjectScheduledTriggerRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceProjectScheduledTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	projectId := d.Get("project_id").(string)
 	spaceId := d.Get("space_id").(string)
 	project, err := projects.GetByID(client, spaceId, projectId)
@@ -81,7 +80,7 @@ func resourceProjectScheduledTriggerCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceProjectScheduledTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	projectId := d.Get("project_id").(string)
 	spaceId := d.Get("space_id").(string)
 	project, err := projects.GetByID(client, spaceId, projectId)
@@ -112,7 +111,7 @@ func resourceProjectScheduledTriggerUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceProjectScheduledTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	spaceId := d.Get("space_id").(string)
 	err := triggers.DeleteById(client, spaceId, d.Id())
 
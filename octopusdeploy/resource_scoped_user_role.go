@@ -5,7 +5,6 @@ import (
 	"log"
 	"net/http"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,7 +28,7 @@ func resourceScopedUserRoleCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] creating scoped user role: %#v", scopedUserRole)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdScopedUserRole, err := client.ScopedUserRoles.Add(scopedUserRole)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +47,7 @@ func resourceScopedUserRoleCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceScopedUserRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting scoped user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := client.ScopedUserRoles.DeleteByID(d.Id()); err != nil {
 		apiError := err.(*core.APIError)
 		if apiError.StatusCode != http.StatusNotFound {
@@ -65,7 +64,7 @@ func resourceScopedUserRoleDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceScopedUserRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading scoped user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	scopedUserRole, err := client.ScopedUserRoles.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "scoped user role")
@@ -83,7 +82,7 @@ func resourceScopedUserRoleUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] updating scoped user role (%s)", d.Id())
 
 	scopedUserRole := expandScopedUserRole(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedScopedUserRole, err := client.ScopedUserRoles.Update(scopedUserRole)
 	if err != nil {
 		return diag.FromErr(err)
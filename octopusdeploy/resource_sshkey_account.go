@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,7 +27,7 @@ func resourceSSHKeyAccountCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] creating SSH key account: %#v", account)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdAccount, err := accounts.Add(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceSSHKeyAccountCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceSSHKeyAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting SSH key account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := accounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceSSHKeyAccountDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceSSHKeyAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading SSH key account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	accountResource, err := accounts.GetByID(client, d.Get("space_id").(string), d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "SSH key account")
@@ -80,7 +79,7 @@ func resourceSSHKeyAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] updating SSH key account (%s)", d.Id())
 
 	account := expandSSHKeyAccount(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedAccount, err := accounts.Update(client, account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceStaticWorkerPoolCreate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] creating static worker pool: %#v", workerPool)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdWorkerPool, err := workerpools.Add(client, workerPool)
 	if err != nil {
 		return diag.FromErr(err)
@@ -49,7 +48,7 @@ func resourceStaticWorkerPoolDelete(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[INFO] deleting static worker pool (%s)", d.Id())
 	spaceID := d.Get("space_id").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := workerpools.DeleteByID(client, spaceID, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,7 +63,7 @@ func resourceStaticWorkerPoolRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] reading static worker pool (%s)", d.Id())
 	spaceID := d.Get("space_id").(string)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	workerPoolResource, err := workerpools.GetByID(client, spaceID, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "static worker pool")
@@ -84,7 +83,7 @@ func resourceStaticWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] updating static worker pool (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedWorkerPool, err := workerpools.Update(client, workerPool)
 	if err != nil {
 		return diag.FromErr(err)
@@ -7,7 +7,6 @@ import (
 	"log"
 	"sort"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
@@ -33,7 +32,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	log.Printf("[INFO] creating team: %#v", team)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdTeam, err := client.Teams.Add(team)
 	if err != nil {
 		return diag.FromErr(err)
@@ -56,7 +55,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting team (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := client.Teams.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -70,7 +69,7 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading team (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	team, err := client.Teams.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "team")
@@ -95,7 +94,7 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	log.Printf("[INFO] updating team (%s)", d.Id())
 
 	team := expandTeam(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedTeam, err := client.Teams.Update(team)
 	if err != nil {
 		return diag.FromErr(err)
@@ -165,7 +164,7 @@ func resourceTeamUpdateUserRoles(ctx context.Context, d *schema.ResourceData, m
 
 		if len(remove) > 0 || len(add) > 0 {
 			log.Printf("[INFO] user role found diff (%s)", d.Id())
-			client := m.(*client.Client)
+			client := m.(*Config).Client
 			if len(remove) > 0 {
 				log.Printf("[INFO] removing user roles from team (%s)", d.Id())
 				for _, userRole := range remove {
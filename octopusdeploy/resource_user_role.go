@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,7 +27,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	log.Printf("[INFO] creating user role: %#v", userRole)
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	createdUserRole, err := userroles.Add(client, userRole)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +46,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, m inter
 func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	if err := userroles.DeleteByID(client, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +60,7 @@ func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, m inter
 func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	userRole, err := userroles.GetByID(client, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "user role")
@@ -79,7 +78,7 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	log.Printf("[INFO] updating user role (%s)", d.Id())
 
 	userRole := expandUserRole(d)
-	client := m.(*client.Client)
+	client := m.(*Config).Client
 	updatedUserRole, err := userroles.Update(client, userRole)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,197 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/spaceresolver"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// wrapResources applies provider-wide behaviour to every resource of the provider.
+func wrapResources(provider *schema.Provider) {
+	for name, resource := range provider.ResourcesMap {
+		wrapWriteOnlySecrets(resource)
+		wrapSpaceReference(resource)
+		wrapReadOnly(name, resource)
+		wrapVersionRequirements(name, resource)
+	}
+}
+
+// wrapSpaceReference lets the space_id attribute of a resource accept a space name or slug. The reference is resolved
+// to a space ID before the resource sees it, and written back afterwards so the configuration does not drift.
+func wrapSpaceReference(resource *schema.Resource) {
+	if spaceID, ok := resource.Schema["space_id"]; !ok || spaceID.Type != schema.TypeString {
+		return
+	}
+
+	resource.CreateContext = withSpaceReference(resource.CreateContext)
+	resource.ReadContext = withSpaceReference(resource.ReadContext)
+	resource.UpdateContext = withSpaceReference(resource.UpdateContext)
+	resource.DeleteContext = withSpaceReference(resource.DeleteContext)
+}
+
+func withSpaceReference[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](operation F) F {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		reference, spaceID, diags := resolveSpaceReference(d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags = append(diags, operation(ctx, d, meta)...)
+
+		if reference != "" && d.Id() != "" && d.Get("space_id").(string) == spaceID {
+			if err := d.Set("space_id", reference); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// wrapWriteOnlySecrets hands secrets configured through their write-only variants, such as password_wo, to the resource
+// in place of the secret, and keeps them out of state.
+func wrapWriteOnlySecrets(resource *schema.Resource) {
+	secrets := writeOnlySecrets(resource.Schema)
+	if len(secrets) == 0 {
+		return
+	}
+
+	resource.CreateContext = withWriteOnlySecrets(secrets, resource.CreateContext)
+	resource.UpdateContext = withWriteOnlySecrets(secrets, resource.UpdateContext)
+}
+
+func withWriteOnlySecrets[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](secrets []string, operation F) F {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var applied []string
+		for _, secret := range secrets {
+			value, diags := d.GetRawConfigAt(cty.GetAttrPath(secret + writeOnlySuffix))
+			if diags.HasError() {
+				return diags
+			}
+			if value.IsNull() || !value.IsKnown() {
+				continue
+			}
+
+			if err := d.Set(secret, value.AsString()); err != nil {
+				return diag.FromErr(err)
+			}
+			applied = append(applied, secret)
+		}
+
+		diags := operation(ctx, d, meta)
+
+		for _, secret := range applied {
+			if err := d.Set(secret, nil); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// wrapReadOnly refuses to create, update or delete the resource when the provider is read only.
+func wrapReadOnly(name string, resource *schema.Resource) {
+	resource.CreateContext = withReadOnly(name, "create", resource.CreateContext)
+	resource.UpdateContext = withReadOnly(name, "update", resource.UpdateContext)
+	resource.DeleteContext = withReadOnly(name, "delete", resource.DeleteContext)
+}
+
+func withReadOnly[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](name string, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if config, ok := meta.(*Config); ok && config != nil && config.ReadOnly {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  providerconfig.ReadOnlySummary,
+				Detail:   providerconfig.ReadOnlyDetail(operation, name),
+			}}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// wrapVersionRequirements checks the resource, and the attributes configured for it, against the version matrix when
+// it is planned, so configuration the connected server cannot accept fails before it is applied.
+func wrapVersionRequirements(name string, resource *schema.Resource) {
+	requirements := providerconfig.VersionRequirements(name)
+	if len(requirements) == 0 {
+		return
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if config, ok := meta.(*Config); ok && config != nil {
+			for _, requirement := range requirements {
+				if requirement.Attribute != "" && !isConfigured(d, requirement.Attribute) {
+					continue
+				}
+
+				if incompatibility := config.CheckVersionRequirement(requirement); incompatibility != nil {
+					return fmt.Errorf("%s: %s", incompatibility.Summary, incompatibility.Detail)
+				}
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, meta)
+		}
+		return nil
+	}
+}
+
+func isConfigured(d *schema.ResourceDiff, attribute string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(attribute) {
+		return false
+	}
+
+	value := config.GetAttr(attribute)
+	if value.IsNull() {
+		return false
+	}
+	if value.IsKnown() && (value.Type().IsListType() || value.Type().IsSetType()) {
+		return value.LengthInt() > 0
+	}
+	return true
+}
+
+func resolveSpaceReference(d *schema.ResourceData, meta interface{}) (string, string, diag.Diagnostics) {
+	config, ok := meta.(*Config)
+	if !ok || config == nil || config.Client == nil {
+		return "", "", nil
+	}
+
+	reference := d.Get("space_id").(string)
+	if reference == "" || spaceresolver.IsSpaceID(reference) {
+		return "", "", nil
+	}
+
+	spaceID, err := spaceresolver.ForClient(config.Client).Resolve(reference)
+	if err != nil {
+		return "", "", diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return "", "", diag.FromErr(err)
+	}
+
+	return reference, spaceID, nil
+}
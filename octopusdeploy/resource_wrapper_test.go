@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapReadOnlyRefusesChanges(t *testing.T) {
+	called := 0
+	operation := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called++
+		return nil
+	}
+
+	resource := &schema.Resource{
+		CreateContext: operation,
+		ReadContext:   operation,
+		UpdateContext: operation,
+		DeleteContext: operation,
+	}
+	wrapReadOnly("octopusdeploy_example", resource)
+
+	meta := &Config{Config: &providerconfig.Config{Settings: providerconfig.Settings{ReadOnly: true}}}
+	for _, f := range []schema.CreateContextFunc{resource.CreateContext, schema.CreateContextFunc(resource.UpdateContext), schema.CreateContextFunc(resource.DeleteContext)} {
+		diags := f(context.Background(), nil, meta)
+		require.True(t, diags.HasError())
+		require.Equal(t, providerconfig.ReadOnlySummary, diags[0].Summary)
+		require.Contains(t, diags[0].Detail, "octopusdeploy_example")
+	}
+	require.Equal(t, 0, called)
+
+	require.False(t, resource.ReadContext(context.Background(), nil, meta).HasError())
+	require.Equal(t, 1, called)
+
+	writable := &Config{Config: &providerconfig.Config{}}
+	require.False(t, resource.CreateContext(context.Background(), nil, writable).HasError())
+	require.Equal(t, 2, called)
+}
+
+func TestWrapWriteOnlySecretsSendsSecretWithoutStoringIt(t *testing.T) {
+	var sent string
+	resource := &schema.Resource{
+		Schema: addWriteOnlySchema(map[string]*schema.Schema{
+			"token": getTokenSchema(true),
+		}, "token"),
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			sent = d.Get("token").(string)
+			d.SetId("Accounts-1")
+			return nil
+		},
+		ReadContext:   schema.NoopContext,
+		UpdateContext: schema.NoopContext,
+		DeleteContext: schema.NoopContext,
+	}
+	require.NoError(t, resource.InternalValidate(nil, true))
+	wrapWriteOnlySecrets(resource)
+
+	raw := map[string]interface{}{"token_wo": "secret", "token_wo_version": 1}
+	diff, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	require.NoError(t, err)
+
+	configType := resource.CoreConfigSchema().ImpliedType()
+	config := map[string]cty.Value{}
+	for name, attributeType := range configType.AttributeTypes() {
+		config[name] = cty.NullVal(attributeType)
+	}
+	config["token_wo"] = cty.StringVal("secret")
+	config["token_wo_version"] = cty.NumberIntVal(1)
+	diff.RawConfig = cty.ObjectVal(config)
+
+	state, diags := resource.Apply(context.Background(), nil, diff, nil)
+	require.False(t, diags.HasError())
+	require.Equal(t, "secret", sent)
+	require.Empty(t, state.Attributes["token"])
+	require.Equal(t, "1", state.Attributes["token_wo_version"])
+}
+
+func TestAddWriteOnlySchemaMakesRequiredSecretOptional(t *testing.T) {
+	schemaMap := addWriteOnlySchema(map[string]*schema.Schema{
+		"password":   getPasswordSchema(true),
+		"secret_key": getSecretKeySchema(false),
+	}, "password", "secret_key")
+
+	require.True(t, schemaMap["password"].Optional)
+	require.Equal(t, []string{"password", "password_wo"}, schemaMap["password"].ExactlyOneOf)
+	require.Equal(t, []string{"secret_key_wo"}, schemaMap["secret_key"].ConflictsWith)
+	require.True(t, schemaMap["password_wo"].WriteOnly)
+	require.Equal(t, []string{"password_wo"}, schemaMap["password_wo_version"].RequiredWith)
+	require.Equal(t, []string{"password", "secret_key"}, writeOnlySecrets(schemaMap))
+}
@@ -46,7 +46,7 @@ func expandAmazonWebServicesAccount(d *schema.ResourceData) *accounts.AmazonWebS
 }
 
 func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"access_key": {
 			Description: "The access key associated with this AWS account.",
 			Required:    true,
@@ -69,7 +69,7 @@ func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "secret_key")
 }
 
 func setAmazonWebServicesAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AmazonWebServicesAccount) error {
@@ -62,7 +62,7 @@ func expandAzureServicePrincipalAccount(d *schema.ResourceData) *accounts.AzureS
 }
 
 func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"application_id":                    getApplicationIDSchema(true),
 		"authentication_endpoint":           getAuthenticationEndpointSchema(false),
 		"azure_environment":                 getAzureEnvironmentSchema(),
@@ -78,7 +78,7 @@ func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_id":                         getTenantIDSchema(true),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "password")
 }
 
 func setAzureServicePrincipalAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureServicePrincipalAccount) error {
@@ -74,7 +74,7 @@ func expandAzureSubscriptionAccount(d *schema.ResourceData) *accounts.AzureSubsc
 }
 
 func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"azure_environment": getAzureEnvironmentSchema(),
 		"certificate": {
 			Computed:  true,
@@ -107,7 +107,7 @@ func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "certificate")
 }
 
 func setAzureSubscriptionAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureSubscriptionAccount) error {
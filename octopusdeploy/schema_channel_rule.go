@@ -1,7 +1,12 @@
 package octopusdeploy
 
 import (
+	"errors"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/channelrules"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,12 +42,58 @@ func getChannelRuleSchema() map[string]*schema.Schema {
 		},
 		"id": getIDSchema(),
 		"tag": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description:      "A regular expression matched against the pre-release tag of package versions, such as `^$` for releases only or `^beta` for beta pre-releases.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateChannelRuleTag(),
 		},
 		"version_range": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description:      "The range of package versions in NuGet or Maven syntax, such as `[1.0,2.0)` for versions from 1.0 up to but excluding 2.0.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateChannelRuleVersionRange(),
 		},
 	}
 }
+
+func validateChannelRuleVersionRange() schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		if _, err := channelrules.ParseRange(v.(string)); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid channel rule version range",
+				Detail:        err.Error(),
+				AttributePath: path,
+			}}
+		}
+		return nil
+	}
+}
+
+// validateChannelRuleTag rejects tags that are not regular expressions. Tags using .NET-only syntax, such as lookaround
+// or backreferences, only produce a warning because Octopus evaluates tags with .NET regular expressions, which Go
+// cannot check.
+func validateChannelRuleTag() schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		err := channelrules.Rule{Tag: v.(string)}.Validate()
+		if err == nil {
+			return nil
+		}
+
+		if errors.Is(err, channelrules.ErrUnsupportedTag) {
+			return diag.Diagnostics{{
+				Severity:      diag.Warning,
+				Summary:       "Channel rule tag cannot be checked",
+				Detail:        err.Error(),
+				AttributePath: path,
+			}}
+		}
+
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid channel rule tag",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+}
@@ -0,0 +1,27 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateChannelRuleVersionRange(t *testing.T) {
+	require.Empty(t, validateChannelRuleVersionRange()("[1.0,2.0)", cty.Path{}))
+
+	diags := validateChannelRuleVersionRange()("[1.0,2.0", cty.Path{})
+	require.True(t, diags.HasError())
+}
+
+func TestValidateChannelRuleTag(t *testing.T) {
+	require.Empty(t, validateChannelRuleTag()("^$", cty.Path{}))
+	require.True(t, validateChannelRuleTag()("beta(", cty.Path{}).HasError())
+
+	for _, tag := range []string{`^(?!beta).+`, `^(beta|rc)\d+\Z`, `^(?>beta)`, `(a)\1`, `^beta\d++`} {
+		diags := validateChannelRuleTag()(tag, cty.Path{})
+		require.Len(t, diags, 1, tag)
+		require.Equal(t, diag.Warning, diags[0].Severity, tag)
+	}
+}
@@ -45,7 +45,7 @@ func expandGoogleCloudPlatformAccount(d *schema.ResourceData) *accounts.GoogleCl
 }
 
 func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"description": {
 			Description: "A user-friendly description of this GCP account.",
 			Optional:    true,
@@ -68,7 +68,7 @@ func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "json_key")
 }
 
 func setGoogleCloudPlatformAccount(ctx context.Context, d *schema.ResourceData, account *accounts.GoogleCloudPlatformAccount) error {
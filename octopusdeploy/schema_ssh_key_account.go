@@ -46,7 +46,7 @@ func expandSSHKeyAccount(d *schema.ResourceData) *accounts.SSHKeyAccount {
 }
 
 func getSSHKeyAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"description":  getDescriptionSchema("SSH key account"),
 		"environments": getEnvironmentsSchema(),
 		"id":           getIDSchema(),
@@ -68,7 +68,7 @@ func getSSHKeyAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"username":                          getUsernameSchema(true),
-	}
+	}, "private_key_file", "private_key_passphrase")
 }
 
 func warnIfSshPassphraseLooksLikeFile() schema.SchemaValidateDiagFunc {
@@ -44,7 +44,7 @@ func expandTokenAccount(d *schema.ResourceData) *accounts.TokenAccount {
 }
 
 func getTokenAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addWriteOnlySchema(map[string]*schema.Schema{
 		"description":                       getDescriptionSchema("token account"),
 		"environments":                      getEnvironmentsSchema(),
 		"id":                                getIDSchema(),
@@ -54,7 +54,7 @@ func getTokenAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"token":                             getTokenSchema(true),
-	}
+	}, "token")
 }
 
 func setTokenAccount(ctx context.Context, d *schema.ResourceData, account *accounts.TokenAccount) error {
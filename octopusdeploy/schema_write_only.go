@@ -0,0 +1,79 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
+)
+
+// addWriteOnlySchema adds a write-only variant, such as password_wo, and its version, such as password_wo_version, for
+// each of the named secret attributes. The secret may then be set with either attribute: a required secret becomes
+// optional, and exactly one of the two must be configured.
+func addWriteOnlySchema(schemaMap map[string]*schema.Schema, names ...string) map[string]*schema.Schema {
+	for _, name := range names {
+		secret, ok := schemaMap[name]
+		if !ok || secret.Type != schema.TypeString {
+			panic(fmt.Sprintf("%s is not a string attribute and cannot have a write-only variant", name))
+		}
+
+		writeOnlyName := name + writeOnlySuffix
+		versionName := name + writeOnlyVersionSuffix
+
+		if secret.Required {
+			secret.Required = false
+			secret.Optional = true
+			secret.ExactlyOneOf = []string{name, writeOnlyName}
+		} else {
+			secret.ConflictsWith = append(secret.ConflictsWith, writeOnlyName)
+		}
+
+		description := secret.Description
+		if description == "" {
+			description = fmt.Sprintf("The write-only variant of `%s`.", name)
+		}
+
+		schemaMap[writeOnlyName] = &schema.Schema{
+			Description:      fmt.Sprintf("%s This value is write-only: it is sent to Octopus Deploy but never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Change `%s` to send a new value.", description, versionName),
+			Optional:         true,
+			Sensitive:        true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			WriteOnly:        true,
+		}
+
+		schemaMap[versionName] = &schema.Schema{
+			Description:  fmt.Sprintf("A version for `%s`. Change it whenever `%s` changes, so the new value is sent to Octopus Deploy.", writeOnlyName, writeOnlyName),
+			Optional:     true,
+			RequiredWith: []string{writeOnlyName},
+			Type:         schema.TypeInt,
+		}
+	}
+
+	return schemaMap
+}
+
+// writeOnlySecrets returns the secret attributes of a schema that have a write-only variant.
+func writeOnlySecrets(schemaMap map[string]*schema.Schema) []string {
+	var names []string
+	for name, attribute := range schemaMap {
+		if !strings.HasSuffix(name, writeOnlySuffix) || !attribute.WriteOnly {
+			continue
+		}
+
+		secret := strings.TrimSuffix(name, writeOnlySuffix)
+		if _, ok := schemaMap[secret]; ok {
+			names = append(names, secret)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
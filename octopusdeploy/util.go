@@ -3,7 +3,6 @@ package octopusdeploy
 import (
 	"hash/crc32"
 	"log"
-	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -96,13 +95,6 @@ func logResource(name string, resource interface{}) {
 	log.Printf("[DEBUG] %s: %#v", name, resource)
 }
 
-func getStringOrEmpty(tfAttr interface{}) string {
-	if tfAttr == nil {
-		return ""
-	}
-	return tfAttr.(string)
-}
-
 func stringHashCode(s string) int {
 	v := int(crc32.ChecksumIEEE([]byte(s)))
 	if v >= 0 {
@@ -113,23 +105,3 @@ func stringHashCode(s string) int {
 	}
 	return 0
 }
-
-func formatBoolForActionProperty(b bool) string {
-	if b {
-		return "True"
-	}
-	return "False"
-}
-
-func formatAsBoolOrBoundedValueForActionProperty(value string) string {
-	if strings.Contains(value, "#{") {
-		return value
-	}
-
-	boolValue, err := strconv.ParseBool(value)
-	if err == nil {
-		return formatBoolForActionProperty(boolValue)
-	}
-
-	return value
-}
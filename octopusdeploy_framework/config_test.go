@@ -1,6 +1,7 @@
 package octopusdeploy_framework
 
 import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -35,14 +36,14 @@ func TestEnsureResourceCompatibilityByVersionIgnoresBranches(t *testing.T) {
 }
 
 func testServerVersionShouldPass(t *testing.T, limit string, current string) {
-	configuration := Config{OctopusVersion: current}
+	configuration := Config{Config: &providerconfig.Config{OctopusVersion: current}}
 	diags := configuration.EnsureResourceCompatibilityByVersion("compatible_resource_name", limit)
 
 	assert.False(t, diags.HasError(), "Expected %s to pass limit %s", current, limit)
 }
 
 func testServerVersionShouldFail(t *testing.T, limit string, current string) {
-	configuration := Config{OctopusVersion: current}
+	configuration := Config{Config: &providerconfig.Config{OctopusVersion: current}}
 	diags := configuration.EnsureResourceCompatibilityByVersion("incompatible_resource_name", limit)
 
 	assert.True(t, diags.HasError(), "Expected %s to fail limit %s", current, limit)
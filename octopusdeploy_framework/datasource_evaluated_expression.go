@@ -0,0 +1,54 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octostache"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type evaluatedExpressionDataSource struct{}
+
+func NewEvaluatedExpressionDataSource() datasource.DataSource {
+	return &evaluatedExpressionDataSource{}
+}
+
+func (*evaluatedExpressionDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName("evaluated_expression")
+}
+
+func (*evaluatedExpressionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.EvaluatedExpressionSchema{}.GetDatasourceSchema()
+}
+
+func (*evaluatedExpressionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.EvaluatedExpressionDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables := map[string]string{}
+	if !data.Variables.IsNull() {
+		resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result, err := octostache.Evaluate(data.Template.ValueString(), variables)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("template"), "unable to evaluate template", err.Error())
+		return
+	}
+
+	data.Result = types.StringValue(result)
+	data.ID = types.StringValue("Evaluated Expression " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,603 @@
+package octopusdeploy_framework
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/gitdependencies"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The action properties that are modelled by attributes shared by several action blocks.
+const (
+	actionEnabledFeaturesProperty    = "Octopus.Action.EnabledFeatures"
+	actionRunOnServerProperty        = "Octopus.Action.RunOnServer"
+	actionTemplateIDProperty         = "Octopus.Action.Template.Id"
+	actionTemplateVersionProperty    = "Octopus.Action.Template.Version"
+	kubernetesStatusCheckProperty    = "Octopus.Action.Kubernetes.ResourceStatusCheck"
+	primaryPackageDownloadProperty   = "Octopus.Action.Package.DownloadOnTentacle"
+	primaryPackageFeedIDProperty     = "Octopus.Action.Package.FeedId"
+	primaryPackageIDProperty         = "Octopus.Action.Package.PackageId"
+	packageReferenceExtractProperty  = "Extract"
+	substituteInFilesFeature         = "Octopus.Features.SubstituteInFiles"
+	substituteInFilesEnabledProperty = "Octopus.Action.SubstituteInFiles.Enabled"
+	substituteInFilesTargetsProperty = "Octopus.Action.SubstituteInFiles.TargetFiles"
+	windowsServiceFeature            = "Octopus.Features.WindowsService"
+)
+
+// kubernetesStatusCheckActionTypes are the Kubernetes action types whose object status check is enabled when the
+// configuration does not say otherwise, as it is in the Octopus Deploy UI.
+var kubernetesStatusCheckActionTypes = []string{
+	"Octopus.KubernetesDeployContainers",
+	"Octopus.KubernetesDeployRawYaml",
+	"Octopus.KubernetesDeployService",
+	"Octopus.KubernetesDeployIngress",
+	"Octopus.KubernetesDeployConfigMap",
+	"Octopus.Kustomize",
+}
+
+// expandActionBase returns an action with the attributes shared by every action block. current is the action of the
+// same name in the deployment process, or nil for a new action; computed attributes that are not known until the
+// action is saved keep its values.
+func expandActionBase(actionType string, plan schemas.DeploymentActionBaseModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	if current == nil {
+		current = deployments.NewDeploymentAction("", "")
+	}
+
+	action := deployments.NewDeploymentAction(plan.Name.ValueString(), actionType)
+	action.ID = current.ID
+	if plan.ID.ValueString() != "" {
+		action.ID = plan.ID.ValueString()
+	}
+	action.Slug = current.Slug
+
+	if plan.Properties.IsUnknown() {
+		for k, v := range current.Properties {
+			action.Properties[k] = v
+		}
+	} else {
+		for k, v := range expandStringMap(plan.Properties) {
+			// Sensitive values are read as empty strings, so an empty value keeps the sensitive value.
+			if p, ok := current.Properties[k]; ok && p.IsSensitive && v == "" {
+				action.Properties[k] = p
+				continue
+			}
+			action.Properties[k] = newPropertyValue(v)
+		}
+	}
+
+	action.CanBeUsedForProjectVersioning = current.CanBeUsedForProjectVersioning
+	if !plan.CanBeUsedForProjectVersioning.IsUnknown() {
+		action.CanBeUsedForProjectVersioning = plan.CanBeUsedForProjectVersioning.ValueBool()
+	}
+	action.Condition = current.Condition
+	if !plan.Condition.IsUnknown() {
+		action.Condition = plan.Condition.ValueString()
+	}
+	action.Channels = expandComputedStringList(plan.Channels, current.Channels)
+	action.Environments = expandComputedStringList(plan.Environments, current.Environments)
+	action.ExcludedEnvironments = expandComputedStringList(plan.ExcludedEnvironments, current.ExcludedEnvironments)
+	action.TenantTags = expandComputedStringList(plan.TenantTags, current.TenantTags)
+	action.IsDisabled = plan.IsDisabled.ValueBool()
+	action.IsRequired = plan.IsRequired.ValueBool()
+	action.Notes = plan.Notes.ValueString()
+
+	if !plan.Features.IsUnknown() {
+		setOptionalProperty(action.Properties, actionEnabledFeaturesProperty, strings.Join(util.ExpandStringList(plan.Features), ","))
+	}
+
+	action.Container = current.Container
+	if len(plan.Container) > 0 {
+		action.Container = &deployments.DeploymentActionContainer{
+			FeedID: plan.Container[0].FeedID.ValueString(),
+			Image:  plan.Container[0].Image.ValueString(),
+		}
+	}
+
+	action.GitDependencies = current.GitDependencies
+	if len(plan.GitDependency) > 0 {
+		action.GitDependencies = []*gitdependencies.GitDependency{expandGitDependency(plan.GitDependency[0], current.GitDependencies)}
+	}
+
+	if len(plan.ActionTemplate) > 0 {
+		template := plan.ActionTemplate[0]
+		action.Properties[actionTemplateIDProperty] = newPropertyValue(template.ID.ValueString())
+		if !template.Version.IsUnknown() {
+			action.Properties[actionTemplateVersionProperty] = newPropertyValue(strconv.FormatInt(template.Version.ValueInt64(), 10))
+		}
+	}
+
+	if slices.Contains(kubernetesStatusCheckActionTypes, actionType) {
+		if _, ok := action.Properties[kubernetesStatusCheckProperty]; !ok {
+			action.Properties[kubernetesStatusCheckProperty] = newPropertyValue(formatBoolProperty(true))
+		}
+	}
+
+	return action
+}
+
+// expandDeploymentAction returns the action of a generic action block.
+func expandDeploymentAction(plan schemas.DeploymentActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase(plan.ActionType.ValueString(), plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandNamedPackages(action, plan.Packages, current)
+	return action
+}
+
+// flattenDeploymentAction returns the generic action block of an action.
+func flattenDeploymentAction(action *deployments.DeploymentAction, prior *schemas.DeploymentActionModel, position int) schemas.DeploymentActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeploymentActionModel{}
+	}
+
+	return schemas.DeploymentActionModel{
+		ActionType:                     types.StringValue(action.ActionType),
+		PrimaryPackage:                 flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                       flattenNamedPackages(action, prior.Packages, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+	}
+}
+
+// priorActionBase returns the shared attributes of a prior action block, or nil when the action is imported.
+func priorActionBase(imported bool, prior schemas.DeploymentActionBaseModel) *schemas.DeploymentActionBaseModel {
+	if imported {
+		return nil
+	}
+	return &prior
+}
+
+// expandActionExecution applies the attributes that decide where an action runs.
+func expandActionExecution(action *deployments.DeploymentAction, plan schemas.DeploymentActionExecutionModel) {
+	if !plan.RunOnServer.IsUnknown() {
+		action.Properties[actionRunOnServerProperty] = newPropertyValue(formatBoolProperty(plan.RunOnServer.ValueBool()))
+	}
+	action.WorkerPool = plan.WorkerPoolID.ValueString()
+	action.WorkerPoolVariable = plan.WorkerPoolVariable.ValueString()
+}
+
+// expandComputedStringList returns the values of an optional and computed list, or current when the list is unknown.
+func expandComputedStringList(value types.List, current []string) []string {
+	if value.IsUnknown() {
+		return current
+	}
+	return util.ExpandStringList(value)
+}
+
+func expandGitDependency(plan schemas.GitDependencyModel, current []*gitdependencies.GitDependency) *gitdependencies.GitDependency {
+	dependency := &gitdependencies.GitDependency{
+		RepositoryUri:     plan.RepositoryUri.ValueString(),
+		DefaultBranch:     plan.DefaultBranch.ValueString(),
+		GitCredentialType: plan.GitCredentialType.ValueString(),
+		FilePathFilters:   util.ExpandStringList(plan.FilePathFilters),
+		GitCredentialId:   plan.GitCredentialID.ValueString(),
+	}
+	if len(current) > 0 {
+		dependency.Name = current[0].Name
+	}
+	return dependency
+}
+
+// expandPrimaryPackage adds the primary package of an action, and sets the action properties that refer to it. When
+// no primary package is configured the action keeps its current primary package.
+func expandPrimaryPackage(action *deployments.DeploymentAction, plan []schemas.PackageReferenceModel, current *deployments.DeploymentAction) {
+	currentPackage := findPackageReference(current, "")
+	if len(plan) == 0 {
+		if currentPackage != nil {
+			action.Packages = append(action.Packages, currentPackage)
+		}
+		return
+	}
+
+	reference := expandPackageReference(plan[0], currentPackage)
+	if reference.AcquisitionLocation == "Server" {
+		action.Properties[primaryPackageDownloadProperty] = newPropertyValue("False")
+	} else {
+		action.Properties[primaryPackageDownloadProperty] = newPropertyValue(reference.AcquisitionLocation)
+	}
+	if reference.PackageID != "" {
+		action.Properties[primaryPackageIDProperty] = newPropertyValue(reference.PackageID)
+	}
+	if reference.FeedID != "" {
+		action.Properties[primaryPackageFeedIDProperty] = newPropertyValue(reference.FeedID)
+	}
+	action.Packages = append(action.Packages, reference)
+}
+
+// expandPackages adds the packages of the package block of an action. When no package is configured the action keeps
+// its current packages, other than its primary package when the action block has a primary_package block.
+func expandPackages(action *deployments.DeploymentAction, plan []schemas.PackageReferenceModel, current *deployments.DeploymentAction, hasPrimaryPackage bool) {
+	if len(plan) == 0 {
+		action.Packages = append(action.Packages, otherPackageReferences(current, hasPrimaryPackage)...)
+		return
+	}
+
+	for _, model := range plan {
+		action.Packages = append(action.Packages, expandPackageReference(model, findPackageReference(current, model.Name.ValueString())))
+	}
+}
+
+// expandNamedPackages adds the packages of a package block whose packages are named and can be extracted during the
+// deployment.
+func expandNamedPackages(action *deployments.DeploymentAction, plan []schemas.NamedPackageReferenceModel, current *deployments.DeploymentAction) {
+	if len(plan) == 0 {
+		action.Packages = append(action.Packages, otherPackageReferences(current, true)...)
+		return
+	}
+
+	for _, model := range plan {
+		currentPackage := findPackageReference(current, model.Name.ValueString())
+		reference := expandPackageReference(model.PackageReferenceModel, currentPackage)
+		if !model.ExtractDuringDeployment.IsUnknown() {
+			reference.Properties[packageReferenceExtractProperty] = formatBoolProperty(model.ExtractDuringDeployment.ValueBool())
+		} else if currentPackage != nil {
+			if v, ok := currentPackage.Properties[packageReferenceExtractProperty]; ok {
+				reference.Properties[packageReferenceExtractProperty] = v
+			}
+		}
+		action.Packages = append(action.Packages, reference)
+	}
+}
+
+// expandPackageReference returns a package reference, keeping the ID and properties of the current reference when
+// they are not known.
+func expandPackageReference(plan schemas.PackageReferenceModel, current *packages.PackageReference) *packages.PackageReference {
+	reference := &packages.PackageReference{
+		AcquisitionLocation: plan.AcquisitionLocation.ValueString(),
+		FeedID:              plan.FeedID.ValueString(),
+		ID:                  plan.ID.ValueString(),
+		Name:                plan.Name.ValueString(),
+		PackageID:           plan.PackageID.ValueString(),
+		Properties:          map[string]string{},
+	}
+
+	if current != nil && reference.ID == "" {
+		reference.ID = current.ID
+	}
+	if plan.Properties.IsUnknown() {
+		if current != nil {
+			for k, v := range current.Properties {
+				reference.Properties[k] = v
+			}
+		}
+	} else {
+		for k, v := range expandStringMap(plan.Properties) {
+			reference.Properties[k] = v
+		}
+	}
+	return reference
+}
+
+func findPackageReference(action *deployments.DeploymentAction, name string) *packages.PackageReference {
+	if action == nil {
+		return nil
+	}
+	for _, reference := range action.Packages {
+		if reference.Name == name {
+			return reference
+		}
+	}
+	return nil
+}
+
+// otherPackageReferences returns the packages of an action, without its primary package when excludePrimary is set.
+func otherPackageReferences(action *deployments.DeploymentAction, excludePrimary bool) []*packages.PackageReference {
+	var references []*packages.PackageReference
+	if action == nil {
+		return references
+	}
+	for _, reference := range action.Packages {
+		if excludePrimary && reference.Name == "" {
+			continue
+		}
+		references = append(references, reference)
+	}
+	return references
+}
+
+// addActionFeature adds a feature to the enabled features of an action.
+func addActionFeature(action *deployments.DeploymentAction, feature string) {
+	features := splitActionFeatures(action.Properties[actionEnabledFeaturesProperty].Value)
+	if !slices.Contains(features, feature) {
+		features = append(features, feature)
+	}
+	action.Properties[actionEnabledFeaturesProperty] = newPropertyValue(strings.Join(features, ","))
+}
+
+func splitActionFeatures(features string) []string {
+	var values []string
+	for _, feature := range strings.Split(features, ",") {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			values = append(values, feature)
+		}
+	}
+	return values
+}
+
+// flattenActionBase returns the attributes shared by every action block. prior is the action block in the plan or
+// state, or nil when the action is imported. Blocks are only flattened when they are in prior, or after an import, so
+// settings added by Octopus Deploy do not show as changes.
+func flattenActionBase(action *deployments.DeploymentAction, prior *schemas.DeploymentActionBaseModel, position int) schemas.DeploymentActionBaseModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeploymentActionBaseModel{}
+	}
+
+	state := schemas.DeploymentActionBaseModel{
+		ActionTemplate:                []schemas.ActionTemplateModel{},
+		CanBeUsedForProjectVersioning: types.BoolValue(action.CanBeUsedForProjectVersioning),
+		Channels:                      util.FlattenStringList(action.Channels),
+		Condition:                     types.StringValue(action.Condition),
+		Container:                     []schemas.DeploymentActionContainerModel{},
+		Environments:                  util.FlattenStringList(action.Environments),
+		ExcludedEnvironments:          util.FlattenStringList(action.ExcludedEnvironments),
+		Features:                      flattenActionFeatures(action, prior.Features),
+		GitDependency:                 []schemas.GitDependencyModel{},
+		ID:                            types.StringValue(action.GetID()),
+		IsDisabled:                    types.BoolValue(action.IsDisabled),
+		IsRequired:                    types.BoolValue(action.IsRequired),
+		Name:                          types.StringValue(action.Name),
+		Notes:                         flattenOptionalString(action.Notes, prior.Notes),
+		Properties:                    flattenManagedProperties(flattenPropertyValues(action.Properties), prior.Properties),
+		Slug:                          types.StringValue(action.Slug),
+		SortOrder:                     types.Int64Value(int64(position + 1)),
+		TenantTags:                    util.FlattenStringList(action.TenantTags),
+	}
+	if !prior.SortOrder.IsNull() && !prior.SortOrder.IsUnknown() {
+		state.SortOrder = prior.SortOrder
+	}
+
+	if templateID, ok := action.Properties[actionTemplateIDProperty]; ok && flattenBlock(imported, len(prior.ActionTemplate) > 0) {
+		template := schemas.ActionTemplateModel{
+			CommunityActionTemplateID: types.StringValue(""),
+			ID:                        types.StringValue(templateID.Value),
+		}
+		if len(prior.ActionTemplate) > 0 && !prior.ActionTemplate[0].CommunityActionTemplateID.IsUnknown() {
+			template.CommunityActionTemplateID = prior.ActionTemplate[0].CommunityActionTemplateID
+		}
+		version, _ := strconv.ParseInt(action.Properties[actionTemplateVersionProperty].Value, 10, 64)
+		template.Version = types.Int64Value(version)
+		state.ActionTemplate = append(state.ActionTemplate, template)
+	}
+
+	if container := action.Container; container != nil && (container.FeedID != "" || container.Image != "") && flattenBlock(imported, len(prior.Container) > 0) {
+		var priorContainer schemas.DeploymentActionContainerModel
+		if len(prior.Container) > 0 {
+			priorContainer = prior.Container[0]
+		}
+		state.Container = append(state.Container, schemas.DeploymentActionContainerModel{
+			FeedID: flattenOptionalString(container.FeedID, priorContainer.FeedID),
+			Image:  flattenOptionalString(container.Image, priorContainer.Image),
+		})
+	}
+
+	if len(action.GitDependencies) > 0 && flattenBlock(imported, len(prior.GitDependency) > 0) {
+		var priorDependency schemas.GitDependencyModel
+		if len(prior.GitDependency) > 0 {
+			priorDependency = prior.GitDependency[0]
+		}
+		dependency := action.GitDependencies[0]
+		filePathFilters := types.ListNull(types.StringType)
+		if len(dependency.FilePathFilters) > 0 || !priorDependency.FilePathFilters.IsNull() {
+			filePathFilters = util.FlattenStringList(dependency.FilePathFilters)
+		}
+		state.GitDependency = append(state.GitDependency, schemas.GitDependencyModel{
+			RepositoryUri:     types.StringValue(dependency.RepositoryUri),
+			DefaultBranch:     types.StringValue(dependency.DefaultBranch),
+			GitCredentialType: types.StringValue(dependency.GitCredentialType),
+			FilePathFilters:   filePathFilters,
+			GitCredentialID:   flattenOptionalString(dependency.GitCredentialId, priorDependency.GitCredentialID),
+		})
+	}
+
+	return state
+}
+
+// flattenActionExecution returns the attributes that decide where an action runs.
+func flattenActionExecution(action *deployments.DeploymentAction, prior schemas.DeploymentActionExecutionModel) schemas.DeploymentActionExecutionModel {
+	return schemas.DeploymentActionExecutionModel{
+		RunOnServer:        types.BoolValue(parseBoolProperty(action.Properties[actionRunOnServerProperty].Value)),
+		WorkerPoolID:       flattenOptionalString(action.WorkerPool, prior.WorkerPoolID),
+		WorkerPoolVariable: flattenOptionalString(action.WorkerPoolVariable, prior.WorkerPoolVariable),
+	}
+}
+
+// flattenActionFeatures returns the enabled features of an action. When the features are managed, only the managed
+// features the action still has are returned, so features Octopus Deploy enables do not show as changes.
+func flattenActionFeatures(action *deployments.DeploymentAction, prior types.List) types.List {
+	features := splitActionFeatures(action.Properties[actionEnabledFeaturesProperty].Value)
+	if prior.IsNull() || prior.IsUnknown() {
+		return util.FlattenStringList(features)
+	}
+
+	managed := []string{}
+	for _, feature := range util.ExpandStringList(prior) {
+		if slices.Contains(features, feature) {
+			managed = append(managed, feature)
+		}
+	}
+	return util.FlattenStringList(managed)
+}
+
+// flattenPrimaryPackage returns the primary_package block of an action.
+func flattenPrimaryPackage(action *deployments.DeploymentAction, prior []schemas.PackageReferenceModel, imported bool) []schemas.PackageReferenceModel {
+	packageReferences := []schemas.PackageReferenceModel{}
+	reference := findPackageReference(action, "")
+	if reference == nil || !flattenBlock(imported, len(prior) > 0) {
+		return packageReferences
+	}
+
+	var priorPackage schemas.PackageReferenceModel
+	if len(prior) > 0 {
+		priorPackage = prior[0]
+	}
+	return append(packageReferences, flattenPackageReference(reference, priorPackage))
+}
+
+// flattenPackages returns the package block of an action, without its primary package when the action block has a
+// primary_package block.
+func flattenPackages(action *deployments.DeploymentAction, prior []schemas.PackageReferenceModel, imported bool, hasPrimaryPackage bool) []schemas.PackageReferenceModel {
+	packageReferences := []schemas.PackageReferenceModel{}
+	if !flattenBlock(imported, len(prior) > 0) {
+		return packageReferences
+	}
+
+	priorNames := make([]string, len(prior))
+	for i, p := range prior {
+		priorNames[i] = p.Name.ValueString()
+	}
+	references := otherPackageReferences(action, hasPrimaryPackage)
+	sortByPriorNames(references, func(r *packages.PackageReference) string { return r.Name }, priorNames)
+
+	for _, reference := range references {
+		var priorPackage schemas.PackageReferenceModel
+		if i := slices.Index(priorNames, reference.Name); i >= 0 {
+			priorPackage = prior[i]
+		}
+		packageReferences = append(packageReferences, flattenPackageReference(reference, priorPackage))
+	}
+	return packageReferences
+}
+
+// flattenNamedPackages returns the package block of an action block whose packages are named and can be extracted
+// during the deployment.
+func flattenNamedPackages(action *deployments.DeploymentAction, prior []schemas.NamedPackageReferenceModel, imported bool) []schemas.NamedPackageReferenceModel {
+	priorPackages := make([]schemas.PackageReferenceModel, len(prior))
+	for i, p := range prior {
+		priorPackages[i] = p.PackageReferenceModel
+	}
+
+	packageReferences := []schemas.NamedPackageReferenceModel{}
+	for _, reference := range flattenPackages(action, priorPackages, imported, true) {
+		extract := findPackageReference(action, reference.Name.ValueString()).Properties[packageReferenceExtractProperty]
+		packageReferences = append(packageReferences, schemas.NamedPackageReferenceModel{
+			ExtractDuringDeployment: types.BoolValue(parseBoolProperty(extract)),
+			PackageReferenceModel:   reference,
+		})
+	}
+	return packageReferences
+}
+
+func flattenPackageReference(reference *packages.PackageReference, prior schemas.PackageReferenceModel) schemas.PackageReferenceModel {
+	return schemas.PackageReferenceModel{
+		AcquisitionLocation: types.StringValue(reference.AcquisitionLocation),
+		FeedID:              types.StringValue(reference.FeedID),
+		ID:                  types.StringValue(reference.ID),
+		Name:                flattenOptionalString(reference.Name, prior.Name),
+		PackageID:           types.StringValue(reference.PackageID),
+		Properties:          flattenManagedProperties(reference.Properties, prior.Properties),
+	}
+}
+
+// flattenBlock decides whether an optional block whose setting the action has is flattened: after an import every
+// setting is flattened, and otherwise only the settings whose block was configured.
+func flattenBlock(imported bool, configured bool) bool {
+	return imported || configured
+}
+
+// sortByPriorNames orders values so those named in prior come first, in the order of prior, followed by the others in
+// their current order. It keeps the blocks of a list in the order they are configured.
+func sortByPriorNames[T any](values []T, name func(T) string, prior []string) {
+	position := func(value T) int {
+		if i := slices.Index(prior, name(value)); i >= 0 {
+			return i
+		}
+		return len(prior)
+	}
+	slices.SortStableFunc(values, func(a, b T) int {
+		return position(a) - position(b)
+	})
+}
+
+// flattenActionString returns an optional attribute of an action block that models a property. Unless the action is
+// imported, an attribute that is not configured stays null, as the property may be set through the properties
+// attribute or by Octopus Deploy.
+func flattenActionString(value string, prior types.String, imported bool) types.String {
+	if !imported && prior.IsNull() {
+		return types.StringNull()
+	}
+	return flattenOptionalString(value, prior)
+}
+
+// flattenActionBool returns an optional bool attribute of an action block that models a property, following the rules
+// of flattenActionString.
+func flattenActionBool(value string, prior types.Bool, imported bool) types.Bool {
+	if prior.IsNull() && (!imported || value == "") {
+		return types.BoolNull()
+	}
+	return types.BoolValue(parseBoolProperty(value))
+}
+
+// flattenActionInt64 returns an optional number attribute of an action block that models a property, following the
+// rules of flattenActionString.
+func flattenActionInt64(value string, prior types.Int64, imported bool) types.Int64 {
+	if prior.IsNull() && (!imported || value == "") {
+		return types.Int64Null()
+	}
+	i, _ := strconv.ParseInt(value, 10, 64)
+	return types.Int64Value(i)
+}
+
+// flattenActionList returns an optional list attribute of an action block that models a property, following the
+// rules of flattenActionString.
+func flattenActionList(values []string, prior types.List, imported bool) types.List {
+	if prior.IsNull() && (!imported || len(values) == 0) {
+		return types.ListNull(types.StringType)
+	}
+	return util.FlattenStringList(values)
+}
+
+// flattenActionMap returns an optional map attribute of an action block that models a property, following the rules
+// of flattenActionString.
+func flattenActionMap(values map[string]string, prior types.Map, imported bool) types.Map {
+	if prior.IsNull() && (!imported || len(values) == 0) {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(values))
+}
+
+// setActionProperty sets a property modelled by an optional attribute of an action block. When the attribute is not
+// set the property is removed, unless it is set through the properties attribute of the block.
+func setActionProperty(action *deployments.DeploymentAction, properties types.Map, key string, value string) {
+	if value == "" {
+		if _, ok := expandStringMap(properties)[key]; ok {
+			return
+		}
+		delete(action.Properties, key)
+		return
+	}
+	action.Properties[key] = newPropertyValue(value)
+}
+
+// setActionBoolProperty sets a property modelled by an optional bool attribute of an action block to "True" or
+// "False", following the rules of setActionProperty. An unknown value leaves the property as it is.
+func setActionBoolProperty(action *deployments.DeploymentAction, properties types.Map, key string, value types.Bool) {
+	if value.IsUnknown() {
+		return
+	}
+	if value.IsNull() {
+		setActionProperty(action, properties, key, "")
+		return
+	}
+	action.Properties[key] = newPropertyValue(formatBoolProperty(value.ValueBool()))
+}
+
+// formatBoolProperty formats a bool the way Octopus Deploy stores it in action properties.
+func formatBoolProperty(value bool) string {
+	if value {
+		return "True"
+	}
+	return "False"
+}
+
+func parseBoolProperty(value string) bool {
+	b, _ := strconv.ParseBool(value)
+	return b
+}
@@ -0,0 +1,242 @@
+package octopusdeploy_framework
+
+import (
+	"strconv"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that applies a Terraform template.
+const (
+	terraformAdditionalActionParamsProperty   = "Octopus.Action.Terraform.AdditionalActionParams"
+	terraformAdditionalInitParamsProperty     = "Octopus.Action.Terraform.AdditionalInitParams"
+	terraformAllowPluginDownloadsProperty     = "Octopus.Action.Terraform.AllowPluginDownloads"
+	terraformAzureAccountProperty             = "Octopus.Action.Terraform.AzureAccount"
+	terraformFileSubstitutionProperty         = "Octopus.Action.Terraform.FileSubstitution"
+	terraformGoogleCloudAccountProperty       = "Octopus.Action.Terraform.GoogleCloudAccount"
+	terraformManagedAccountProperty           = "Octopus.Action.Terraform.ManagedAccount"
+	terraformPluginsDirectoryProperty         = "Octopus.Action.Terraform.PluginsDirectory"
+	terraformRunAutomaticFileSubstitutionProp = "Octopus.Action.Terraform.RunAutomaticFileSubstitution"
+	terraformTemplateDirectoryProperty        = "Octopus.Action.Terraform.TemplateDirectory"
+	terraformTemplateParametersProperty       = "Octopus.Action.Terraform.TemplateParameters"
+	terraformTemplateProperty                 = "Octopus.Action.Terraform.Template"
+	terraformVarFilesProperty                 = "Octopus.Action.Terraform.VarFiles"
+	terraformWorkspaceProperty                = "Octopus.Action.Terraform.Workspace"
+	awsAccountVariableProperty                = "Octopus.Action.AwsAccount.Variable"
+	awsAssumeRoleExternalIDProperty           = "Octopus.Action.Aws.AssumeRoleExternalId"
+	awsAssumeRoleProperty                     = "Octopus.Action.Aws.AssumeRole"
+	awsAssumeRoleSessionDurationProperty      = "Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"
+	awsAssumedRoleArnProperty                 = "Octopus.Action.Aws.AssumedRoleArn"
+	awsAssumedRoleSessionProperty             = "Octopus.Action.Aws.AssumedRoleSession"
+	awsRegionProperty                         = "Octopus.Action.Aws.Region"
+	awsUseInstanceRoleProperty                = "Octopus.Action.AwsAccount.UseInstanceRole"
+	azureAccountVariableProperty              = "Octopus.Action.AzureAccount.Variable"
+	googleCloudAccountVariableProperty        = "Octopus.Action.GoogleCloudAccount.Variable"
+	googleCloudImpersonateServiceAccountProp  = "Octopus.Action.GoogleCloud.ImpersonateServiceAccount"
+	googleCloudProjectProperty                = "Octopus.Action.GoogleCloud.Project"
+	googleCloudRegionProperty                 = "Octopus.Action.GoogleCloud.Region"
+	googleCloudServiceAccountEmailsProperty   = "Octopus.Action.GoogleCloud.ServiceAccountEmails"
+	googleCloudUseVMServiceAccountProperty    = "Octopus.Action.GoogleCloud.UseVMServiceAccount"
+	googleCloudZoneProperty                   = "Octopus.Action.GoogleCloud.Zone"
+)
+
+func expandApplyTerraformTemplateAction(plan schemas.ApplyTerraformTemplateActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.TerraformApply", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandPackages(action, plan.Packages, current, true)
+
+	properties := plan.Properties
+	if len(plan.Template) > 0 {
+		template := plan.Template[0]
+		setActionProperty(action, properties, terraformVarFilesProperty, template.AdditionalVariableFiles.ValueString())
+		setActionProperty(action, properties, terraformTemplateDirectoryProperty, template.Directory.ValueString())
+		setActionBoolProperty(action, properties, terraformRunAutomaticFileSubstitutionProp, template.RunAutomaticFileSubstitution)
+		setActionProperty(action, properties, terraformFileSubstitutionProperty, template.TargetFiles.ValueString())
+	}
+	setActionProperty(action, properties, terraformTemplateParametersProperty, plan.TemplateParameters.ValueString())
+	setActionProperty(action, properties, terraformTemplateProperty, plan.InlineTemplate.ValueString())
+
+	if findPackageReference(action, "") != nil {
+		action.Properties[scriptSourceProperty] = newPropertyValue("Package")
+	} else {
+		action.Properties[scriptSourceProperty] = newPropertyValue("Inline")
+	}
+
+	if len(plan.AdvancedOptions) > 0 {
+		options := plan.AdvancedOptions[0]
+		setActionBoolProperty(action, properties, terraformAllowPluginDownloadsProperty, options.AllowAdditionalPluginDownloads)
+		setActionProperty(action, properties, terraformAdditionalActionParamsProperty, options.ApplyParameters.ValueString())
+		setActionProperty(action, properties, terraformAdditionalInitParamsProperty, options.InitParameters.ValueString())
+		setActionProperty(action, properties, terraformPluginsDirectoryProperty, options.PluginCacheDirectory.ValueString())
+		setActionProperty(action, properties, terraformWorkspaceProperty, options.Workspace.ValueString())
+	}
+
+	setActionProperty(action, properties, terraformManagedAccountProperty, "")
+	if len(plan.AwsAccount) > 0 {
+		action.Properties[terraformManagedAccountProperty] = newPropertyValue("AWS")
+		expandAwsAccount(action, plan.AwsAccount[0], properties)
+	}
+
+	setActionProperty(action, properties, terraformAzureAccountProperty, "")
+	if len(plan.AzureAccount) > 0 {
+		action.Properties[terraformAzureAccountProperty] = newPropertyValue(formatBoolProperty(true))
+		setActionProperty(action, properties, azureAccountVariableProperty, plan.AzureAccount[0].Variable.ValueString())
+	}
+
+	setActionProperty(action, properties, terraformGoogleCloudAccountProperty, "")
+	if len(plan.GoogleCloudAccount) > 0 {
+		account := plan.GoogleCloudAccount[0]
+		action.Properties[terraformGoogleCloudAccountProperty] = newPropertyValue(formatBoolProperty(true))
+		setActionProperty(action, properties, googleCloudAccountVariableProperty, account.Variable.ValueString())
+		setActionBoolProperty(action, properties, googleCloudUseVMServiceAccountProperty, account.UseVMServiceAccount)
+		setActionBoolProperty(action, properties, googleCloudImpersonateServiceAccountProp, account.ImpersonateServiceAccount)
+		setActionProperty(action, properties, googleCloudServiceAccountEmailsProperty, account.ServiceAccountEmails.ValueString())
+		setActionProperty(action, properties, googleCloudZoneProperty, account.Zone.ValueString())
+		setActionProperty(action, properties, googleCloudRegionProperty, account.Region.ValueString())
+		setActionProperty(action, properties, googleCloudProjectProperty, account.Project.ValueString())
+	}
+
+	return action
+}
+
+// expandAwsAccount applies the AWS account of an action, which is shared by the action blocks that use an AWS account.
+func expandAwsAccount(action *deployments.DeploymentAction, account schemas.TerraformTemplateAwsAccountModel, properties types.Map) {
+	setActionProperty(action, properties, awsRegionProperty, account.Region.ValueString())
+	setActionProperty(action, properties, awsAccountVariableProperty, account.Variable.ValueString())
+	setActionBoolProperty(action, properties, awsUseInstanceRoleProperty, account.UseInstanceRole)
+	expandAwsRole(action, account.Role, properties)
+}
+
+// expandAwsRole applies the role an AWS account assumes, which is shared by the action blocks that use an AWS account.
+func expandAwsRole(action *deployments.DeploymentAction, plan []schemas.TerraformTemplateAwsRoleModel, properties types.Map) {
+	if len(plan) == 0 {
+		setActionProperty(action, properties, awsAssumeRoleProperty, "")
+		return
+	}
+
+	role := plan[0]
+	action.Properties[awsAssumeRoleProperty] = newPropertyValue(formatBoolProperty(true))
+	setActionProperty(action, properties, awsAssumedRoleArnProperty, role.Arn.ValueString())
+	setActionProperty(action, properties, awsAssumeRoleExternalIDProperty, role.ExternalID.ValueString())
+	setActionProperty(action, properties, awsAssumedRoleSessionProperty, role.RoleSessionName.ValueString())
+	if !role.SessionDuration.IsNull() && !role.SessionDuration.IsUnknown() {
+		action.Properties[awsAssumeRoleSessionDurationProperty] = newPropertyValue(strconv.FormatInt(role.SessionDuration.ValueInt64(), 10))
+	} else {
+		setActionProperty(action, properties, awsAssumeRoleSessionDurationProperty, "")
+	}
+}
+
+func flattenApplyTerraformTemplateAction(action *deployments.DeploymentAction, prior *schemas.ApplyTerraformTemplateActionModel, position int) schemas.ApplyTerraformTemplateActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.ApplyTerraformTemplateActionModel{}
+	}
+	property := func(key string) string {
+		return action.Properties[key].Value
+	}
+
+	state := schemas.ApplyTerraformTemplateActionModel{
+		InlineTemplate:                 flattenActionString(property(terraformTemplateProperty), prior.InlineTemplate, imported),
+		TemplateParameters:             flattenActionString(property(terraformTemplateParametersProperty), prior.TemplateParameters, imported),
+		PrimaryPackage:                 flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                       flattenPackages(action, prior.Packages, imported, true),
+		AdvancedOptions:                []schemas.TerraformTemplateAdvancedOptionsModel{},
+		AwsAccount:                     []schemas.TerraformTemplateAwsAccountModel{},
+		AzureAccount:                   []schemas.TerraformTemplateAzureAccountModel{},
+		GoogleCloudAccount:             []schemas.TerraformTemplateGoogleAccountModel{},
+		Template:                       []schemas.TerraformTemplateModel{},
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+	}
+
+	var priorOptions schemas.TerraformTemplateAdvancedOptionsModel
+	if len(prior.AdvancedOptions) > 0 {
+		priorOptions = prior.AdvancedOptions[0]
+	}
+	state.AdvancedOptions = append(state.AdvancedOptions, schemas.TerraformTemplateAdvancedOptionsModel{
+		AllowAdditionalPluginDownloads: types.BoolValue(parseBoolProperty(property(terraformAllowPluginDownloadsProperty))),
+		ApplyParameters:                flattenActionString(property(terraformAdditionalActionParamsProperty), priorOptions.ApplyParameters, imported),
+		InitParameters:                 flattenActionString(property(terraformAdditionalInitParamsProperty), priorOptions.InitParameters, imported),
+		PluginCacheDirectory:           flattenActionString(property(terraformPluginsDirectoryProperty), priorOptions.PluginCacheDirectory, imported),
+		Workspace:                      flattenActionString(property(terraformWorkspaceProperty), priorOptions.Workspace, imported),
+	})
+
+	if property(scriptSourceProperty) == "Package" && flattenBlock(imported, len(prior.Template) > 0) {
+		var priorTemplate schemas.TerraformTemplateModel
+		if len(prior.Template) > 0 {
+			priorTemplate = prior.Template[0]
+		}
+		state.Template = append(state.Template, schemas.TerraformTemplateModel{
+			AdditionalVariableFiles:      flattenActionString(property(terraformVarFilesProperty), priorTemplate.AdditionalVariableFiles, imported),
+			Directory:                    flattenActionString(property(terraformTemplateDirectoryProperty), priorTemplate.Directory, imported),
+			RunAutomaticFileSubstitution: flattenActionBool(property(terraformRunAutomaticFileSubstitutionProp), priorTemplate.RunAutomaticFileSubstitution, imported),
+			TargetFiles:                  flattenActionString(property(terraformFileSubstitutionProperty), priorTemplate.TargetFiles, imported),
+		})
+	}
+
+	if property(terraformManagedAccountProperty) == "AWS" && flattenBlock(imported, len(prior.AwsAccount) > 0) {
+		var priorAccount schemas.TerraformTemplateAwsAccountModel
+		if len(prior.AwsAccount) > 0 {
+			priorAccount = prior.AwsAccount[0]
+		}
+		state.AwsAccount = append(state.AwsAccount, flattenAwsAccount(action, priorAccount, imported))
+	}
+
+	if property(terraformAzureAccountProperty) == "True" && flattenBlock(imported, len(prior.AzureAccount) > 0) {
+		var priorAccount schemas.TerraformTemplateAzureAccountModel
+		if len(prior.AzureAccount) > 0 {
+			priorAccount = prior.AzureAccount[0]
+		}
+		state.AzureAccount = append(state.AzureAccount, schemas.TerraformTemplateAzureAccountModel{
+			Variable: flattenActionString(property(azureAccountVariableProperty), priorAccount.Variable, imported),
+		})
+	}
+
+	if property(terraformGoogleCloudAccountProperty) == "True" && flattenBlock(imported, len(prior.GoogleCloudAccount) > 0) {
+		var priorAccount schemas.TerraformTemplateGoogleAccountModel
+		if len(prior.GoogleCloudAccount) > 0 {
+			priorAccount = prior.GoogleCloudAccount[0]
+		}
+		state.GoogleCloudAccount = append(state.GoogleCloudAccount, schemas.TerraformTemplateGoogleAccountModel{
+			Variable:                  flattenActionString(property(googleCloudAccountVariableProperty), priorAccount.Variable, imported),
+			UseVMServiceAccount:       flattenActionBool(property(googleCloudUseVMServiceAccountProperty), priorAccount.UseVMServiceAccount, imported),
+			Project:                   flattenActionString(property(googleCloudProjectProperty), priorAccount.Project, imported),
+			Region:                    flattenActionString(property(googleCloudRegionProperty), priorAccount.Region, imported),
+			Zone:                      flattenActionString(property(googleCloudZoneProperty), priorAccount.Zone, imported),
+			ServiceAccountEmails:      flattenActionString(property(googleCloudServiceAccountEmailsProperty), priorAccount.ServiceAccountEmails, imported),
+			ImpersonateServiceAccount: flattenActionBool(property(googleCloudImpersonateServiceAccountProp), priorAccount.ImpersonateServiceAccount, imported),
+		})
+	}
+
+	return state
+}
+
+func flattenAwsAccount(action *deployments.DeploymentAction, prior schemas.TerraformTemplateAwsAccountModel, imported bool) schemas.TerraformTemplateAwsAccountModel {
+	return schemas.TerraformTemplateAwsAccountModel{
+		Region:          flattenActionString(action.Properties[awsRegionProperty].Value, prior.Region, imported),
+		Role:            flattenAwsRole(action, prior.Role, imported),
+		Variable:        flattenActionString(action.Properties[awsAccountVariableProperty].Value, prior.Variable, imported),
+		UseInstanceRole: flattenActionBool(action.Properties[awsUseInstanceRoleProperty].Value, prior.UseInstanceRole, imported),
+	}
+}
+
+func flattenAwsRole(action *deployments.DeploymentAction, prior []schemas.TerraformTemplateAwsRoleModel, imported bool) []schemas.TerraformTemplateAwsRoleModel {
+	roles := []schemas.TerraformTemplateAwsRoleModel{}
+	if action.Properties[awsAssumeRoleProperty].Value != "True" || !flattenBlock(imported, len(prior) > 0) {
+		return roles
+	}
+
+	var priorRole schemas.TerraformTemplateAwsRoleModel
+	if len(prior) > 0 {
+		priorRole = prior[0]
+	}
+	return append(roles, schemas.TerraformTemplateAwsRoleModel{
+		Arn:             flattenActionString(action.Properties[awsAssumedRoleArnProperty].Value, priorRole.Arn, imported),
+		ExternalID:      flattenActionString(action.Properties[awsAssumeRoleExternalIDProperty].Value, priorRole.ExternalID, imported),
+		RoleSessionName: flattenActionString(action.Properties[awsAssumedRoleSessionProperty].Value, priorRole.RoleSessionName, imported),
+		SessionDuration: flattenActionInt64(action.Properties[awsAssumeRoleSessionDurationProperty].Value, priorRole.SessionDuration, imported),
+	})
+}
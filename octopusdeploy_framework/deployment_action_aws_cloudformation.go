@@ -0,0 +1,258 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of the actions that manage an AWS CloudFormation stack.
+const (
+	awsCapabilitiesProperty                = "Octopus.Action.Aws.IamCapabilities"
+	awsChangeSetArnProperty                = "Octopus.Action.Aws.CloudFormation.ChangeSet.Arn"
+	awsCloudFormationParametersProperty    = "Octopus.Action.Aws.CloudFormationTemplateParameters"
+	awsCloudFormationParametersRawProperty = "Octopus.Action.Aws.CloudFormationTemplateParametersRaw"
+	awsCloudFormationTagsProperty          = "Octopus.Action.Aws.CloudFormation.Tags"
+	awsCloudFormationTemplateProperty      = "Octopus.Action.Aws.CloudFormationTemplate"
+	awsDisableRollbackProperty             = "Octopus.Action.Aws.DisableRollback"
+	awsStackNameProperty                   = "Octopus.Action.Aws.CloudFormationStackName"
+	awsTemplateSourceProperty              = "Octopus.Action.Aws.TemplateSource"
+	awsWaitForCompletionProperty           = "Octopus.Action.Aws.WaitForCompletion"
+)
+
+// cloudFormationTemplate names the properties that hold the template of an AWS CloudFormation action.
+var cloudFormationTemplate = templateProperties{
+	source:     awsTemplateSourceProperty,
+	template:   awsCloudFormationTemplateProperty,
+	parameters: awsCloudFormationParametersProperty,
+}
+
+// templateProperties names the properties that hold the template of an action whose template is either inline or in
+// a package. The template and parameters properties hold the template and its parameters when it is inline, and
+// their paths in the package otherwise.
+type templateProperties struct {
+	source     string
+	template   string
+	parameters string
+}
+
+// cloudFormationParameter is an item of the JSON list in which Octopus Deploy stores the parameters of a template.
+type cloudFormationParameter struct {
+	ParameterKey   string
+	ParameterValue string
+}
+
+// cloudFormationTag is an item of the JSON list in which Octopus Deploy stores the tags of a stack.
+type cloudFormationTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func expandDeployAwsCloudFormationTemplateAction(plan schemas.DeployAwsCloudFormationTemplateActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.AwsRunCloudFormation", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandAwsCloudFormationAction(action, plan.AwsCloudFormationActionModel, plan.Properties)
+
+	properties := plan.Properties
+	parameters := formatCloudFormationParameters(expandStringMap(plan.Parameters))
+	expandTemplateSource(action, plan.TemplateSourceModel, properties, current, cloudFormationTemplate, parameters)
+	rawParameters := ""
+	if len(plan.PrimaryPackage) == 0 {
+		rawParameters = parameters
+	}
+	setActionProperty(action, properties, awsCloudFormationParametersRawProperty, rawParameters)
+
+	if capabilities := util.ExpandStringList(plan.Capabilities); len(capabilities) > 0 {
+		value, _ := json.Marshal(capabilities)
+		action.Properties[awsCapabilitiesProperty] = newPropertyValue(string(value))
+	} else {
+		setActionProperty(action, properties, awsCapabilitiesProperty, "")
+	}
+	setActionBoolProperty(action, properties, awsDisableRollbackProperty, plan.DisableRollback)
+	setActionProperty(action, properties, awsCloudFormationTagsProperty, formatCloudFormationTags(expandStringMap(plan.Tags)))
+	return action
+}
+
+func expandApplyAwsCloudFormationChangeSetAction(plan schemas.ApplyAwsCloudFormationChangeSetActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.AwsApplyCloudFormationChangeSet", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandAwsCloudFormationAction(action, plan.AwsCloudFormationActionModel, plan.Properties)
+	action.Properties[awsChangeSetArnProperty] = newPropertyValue(plan.ChangeSetName.ValueString())
+	return action
+}
+
+func expandDeleteAwsCloudFormationStackAction(plan schemas.DeleteAwsCloudFormationStackActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.AwsDeleteCloudFormation", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandAwsCloudFormationAction(action, plan.AwsCloudFormationActionModel, plan.Properties)
+	return action
+}
+
+func expandAwsCloudFormationAction(action *deployments.DeploymentAction, plan schemas.AwsCloudFormationActionModel, properties types.Map) {
+	action.Properties[awsStackNameProperty] = newPropertyValue(plan.StackName.ValueString())
+	setActionBoolProperty(action, properties, awsWaitForCompletionProperty, plan.WaitForCompletion)
+	if len(plan.AwsAccount) > 0 {
+		expandAwsAccount(action, plan.AwsAccount[0], properties)
+	}
+}
+
+// expandTemplateSource applies the template of an action whose template is either inline or in a package. The
+// parameters of an inline template are passed formatted the way the action stores them.
+func expandTemplateSource(action *deployments.DeploymentAction, plan schemas.TemplateSourceModel, properties types.Map, current *deployments.DeploymentAction, names templateProperties, parameters string) {
+	if len(plan.PrimaryPackage) > 0 {
+		expandPrimaryPackage(action, plan.PrimaryPackage, current)
+		action.Properties[names.source] = newPropertyValue(manifestSourcePackage)
+		setActionProperty(action, properties, names.template, plan.TemplatePath.ValueString())
+		setActionProperty(action, properties, names.parameters, plan.ParametersFilePath.ValueString())
+		return
+	}
+
+	action.Properties[names.source] = newPropertyValue(manifestSourceInline)
+	setActionProperty(action, properties, names.template, plan.InlineTemplate.ValueString())
+	setActionProperty(action, properties, names.parameters, parameters)
+}
+
+func formatCloudFormationParameters(values map[string]string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	parameters := []cloudFormationParameter{}
+	for _, key := range sortedKeys(values) {
+		parameters = append(parameters, cloudFormationParameter{ParameterKey: key, ParameterValue: values[key]})
+	}
+	value, _ := json.Marshal(parameters)
+	return string(value)
+}
+
+func formatCloudFormationTags(values map[string]string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	tags := []cloudFormationTag{}
+	for _, key := range sortedKeys(values) {
+		tags = append(tags, cloudFormationTag{Key: key, Value: values[key]})
+	}
+	value, _ := json.Marshal(tags)
+	return string(value)
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func flattenDeployAwsCloudFormationTemplateAction(action *deployments.DeploymentAction, prior *schemas.DeployAwsCloudFormationTemplateActionModel, position int) schemas.DeployAwsCloudFormationTemplateActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployAwsCloudFormationTemplateActionModel{}
+	}
+
+	var capabilities []string
+	_ = json.Unmarshal([]byte(action.Properties[awsCapabilitiesProperty].Value), &capabilities)
+
+	return schemas.DeployAwsCloudFormationTemplateActionModel{
+		Capabilities:                   flattenActionList(capabilities, prior.Capabilities, imported),
+		DisableRollback:                flattenActionBool(action.Properties[awsDisableRollbackProperty].Value, prior.DisableRollback, imported),
+		Tags:                           flattenActionMap(parseCloudFormationTags(action.Properties[awsCloudFormationTagsProperty].Value), prior.Tags, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		AwsCloudFormationActionModel:   flattenAwsCloudFormationAction(action, prior.AwsCloudFormationActionModel, imported),
+		TemplateSourceModel:            flattenTemplateSource(action, prior.TemplateSourceModel, imported, cloudFormationTemplate, parseCloudFormationParameters),
+	}
+}
+
+func flattenApplyAwsCloudFormationChangeSetAction(action *deployments.DeploymentAction, prior *schemas.ApplyAwsCloudFormationChangeSetActionModel, position int) schemas.ApplyAwsCloudFormationChangeSetActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.ApplyAwsCloudFormationChangeSetActionModel{}
+	}
+
+	return schemas.ApplyAwsCloudFormationChangeSetActionModel{
+		ChangeSetName:                  types.StringValue(action.Properties[awsChangeSetArnProperty].Value),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		AwsCloudFormationActionModel:   flattenAwsCloudFormationAction(action, prior.AwsCloudFormationActionModel, imported),
+	}
+}
+
+func flattenDeleteAwsCloudFormationStackAction(action *deployments.DeploymentAction, prior *schemas.DeleteAwsCloudFormationStackActionModel, position int) schemas.DeleteAwsCloudFormationStackActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeleteAwsCloudFormationStackActionModel{}
+	}
+
+	return schemas.DeleteAwsCloudFormationStackActionModel{
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		AwsCloudFormationActionModel:   flattenAwsCloudFormationAction(action, prior.AwsCloudFormationActionModel, imported),
+	}
+}
+
+// flattenAwsCloudFormationAction returns the attributes shared by the actions that manage an AWS CloudFormation stack.
+// The actions wait for the stack to finish changing unless they are told not to.
+func flattenAwsCloudFormationAction(action *deployments.DeploymentAction, prior schemas.AwsCloudFormationActionModel, imported bool) schemas.AwsCloudFormationActionModel {
+	var priorAccount schemas.TerraformTemplateAwsAccountModel
+	if len(prior.AwsAccount) > 0 {
+		priorAccount = prior.AwsAccount[0]
+	}
+
+	waitForCompletion := action.Properties[awsWaitForCompletionProperty].Value
+	return schemas.AwsCloudFormationActionModel{
+		StackName:         types.StringValue(action.Properties[awsStackNameProperty].Value),
+		WaitForCompletion: types.BoolValue(waitForCompletion == "" || parseBoolProperty(waitForCompletion)),
+		AwsAccount:        []schemas.TerraformTemplateAwsAccountModel{flattenAwsAccount(action, priorAccount, imported)},
+	}
+}
+
+// flattenTemplateSource returns the template of an action whose template is either inline or in a package, reading the
+// parameters of an inline template with parseParameters.
+func flattenTemplateSource(action *deployments.DeploymentAction, prior schemas.TemplateSourceModel, imported bool, names templateProperties, parseParameters func(string) map[string]string) schemas.TemplateSourceModel {
+	var inlineTemplate, inlineParameters, templatePath, parametersFilePath string
+	if action.Properties[names.source].Value == manifestSourcePackage {
+		templatePath = action.Properties[names.template].Value
+		parametersFilePath = action.Properties[names.parameters].Value
+	} else {
+		inlineTemplate = action.Properties[names.template].Value
+		inlineParameters = action.Properties[names.parameters].Value
+	}
+
+	return schemas.TemplateSourceModel{
+		InlineTemplate:     flattenActionString(inlineTemplate, prior.InlineTemplate, imported),
+		Parameters:         flattenActionMap(parseParameters(inlineParameters), prior.Parameters, imported),
+		ParametersFilePath: flattenActionString(parametersFilePath, prior.ParametersFilePath, imported),
+		TemplatePath:       flattenActionString(templatePath, prior.TemplatePath, imported),
+		PrimaryPackage:     flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+	}
+}
+
+func parseCloudFormationParameters(value string) map[string]string {
+	var parameters []cloudFormationParameter
+	_ = json.Unmarshal([]byte(value), &parameters)
+
+	values := map[string]string{}
+	for _, parameter := range parameters {
+		values[parameter.ParameterKey] = parameter.ParameterValue
+	}
+	return values
+}
+
+func parseCloudFormationTags(value string) map[string]string {
+	var tags []cloudFormationTag
+	_ = json.Unmarshal([]byte(value), &tags)
+
+	values := map[string]string{}
+	for _, tag := range tags {
+		values[tag.Key] = tag.Value
+	}
+	return values
+}
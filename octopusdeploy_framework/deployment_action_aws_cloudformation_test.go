@@ -0,0 +1,153 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployAwsCloudFormationTemplateActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Deploy stack", "Octopus.AwsRunCloudFormation")
+	action.ID = "Actions-1"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:              "True",
+		awsAccountVariableProperty:             "AWS.Account",
+		awsAssumeRoleProperty:                  "True",
+		awsAssumedRoleArnProperty:              "arn:aws:iam::123456789012:role/deploy",
+		awsAssumedRoleSessionProperty:          "octopus",
+		awsCapabilitiesProperty:                `["CAPABILITY_IAM","CAPABILITY_NAMED_IAM"]`,
+		awsCloudFormationParametersProperty:    `[{"ParameterKey":"Environment","ParameterValue":"#{Octopus.Environment.Name}"},{"ParameterKey":"InstanceType","ParameterValue":"t3.micro"}]`,
+		awsCloudFormationParametersRawProperty: `[{"ParameterKey":"Environment","ParameterValue":"#{Octopus.Environment.Name}"},{"ParameterKey":"InstanceType","ParameterValue":"t3.micro"}]`,
+		awsCloudFormationTagsProperty:          `[{"key":"team","value":"web"}]`,
+		awsCloudFormationTemplateProperty:      "Resources: {}",
+		awsDisableRollbackProperty:             "False",
+		awsRegionProperty:                      "us-east-1",
+		awsStackNameProperty:                   "web-#{Octopus.Environment.Name}",
+		awsTemplateSourceProperty:              manifestSourceInline,
+		awsWaitForCompletionProperty:           "True",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+
+	imported := flattenDeployAwsCloudFormationTemplateAction(action, nil, 0)
+	require.Equal(t, "web-#{Octopus.Environment.Name}", imported.StackName.ValueString())
+	require.Equal(t, "Resources: {}", imported.InlineTemplate.ValueString())
+	require.Equal(t, map[string]string{"Environment": "#{Octopus.Environment.Name}", "InstanceType": "t3.micro"}, expandStringMap(imported.Parameters))
+	require.True(t, imported.TemplatePath.IsNull())
+	require.Equal(t, []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"}, util.ExpandStringList(imported.Capabilities))
+	require.Equal(t, map[string]string{"team": "web"}, expandStringMap(imported.Tags))
+	require.Len(t, imported.AwsAccount, 1)
+	require.Equal(t, "AWS.Account", imported.AwsAccount[0].Variable.ValueString())
+	require.Len(t, imported.AwsAccount[0].Role, 1)
+	require.Empty(t, imported.PrimaryPackage)
+
+	expanded := expandDeployAwsCloudFormationTemplateAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+
+	require.Equal(t, imported, flattenDeployAwsCloudFormationTemplateAction(expanded, &imported, 0))
+}
+
+func TestDeployAwsCloudFormationTemplateActionFromPackage(t *testing.T) {
+	plan := schemas.DeployAwsCloudFormationTemplateActionModel{
+		Capabilities:    types.ListNull(types.StringType),
+		DisableRollback: types.BoolNull(),
+		Tags:            types.MapNull(types.StringType),
+		AwsCloudFormationActionModel: schemas.AwsCloudFormationActionModel{
+			StackName:         types.StringValue("web"),
+			WaitForCompletion: types.BoolValue(true),
+			AwsAccount: []schemas.TerraformTemplateAwsAccountModel{{
+				Region:          types.StringValue("us-east-1"),
+				Variable:        types.StringNull(),
+				UseInstanceRole: types.BoolValue(true),
+			}},
+		},
+		TemplateSourceModel: schemas.TemplateSourceModel{
+			InlineTemplate:     types.StringNull(),
+			Parameters:         types.MapNull(types.StringType),
+			ParametersFilePath: types.StringValue("parameters.json"),
+			TemplatePath:       types.StringValue("template.yaml"),
+			PrimaryPackage: []schemas.PackageReferenceModel{{
+				PackageID:           types.StringValue("web-stack"),
+				FeedID:              types.StringValue("Feeds-1"),
+				AcquisitionLocation: types.StringValue("Server"),
+				Properties:          types.MapNull(types.StringType),
+			}},
+		},
+	}
+	plan.Name = types.StringValue("Deploy stack")
+	plan.Properties = types.MapUnknown(types.StringType)
+
+	// The action was previously deployed from an inline template.
+	current := deployments.NewDeploymentAction("Deploy stack", "Octopus.AwsRunCloudFormation")
+	current.Properties[awsCloudFormationParametersRawProperty] = newPropertyValue(`[{"ParameterKey":"Environment","ParameterValue":"test"}]`)
+
+	action := expandDeployAwsCloudFormationTemplateAction(plan, current)
+	require.Equal(t, manifestSourcePackage, action.Properties[awsTemplateSourceProperty].Value)
+	require.Equal(t, "template.yaml", action.Properties[awsCloudFormationTemplateProperty].Value)
+	require.Equal(t, "parameters.json", action.Properties[awsCloudFormationParametersProperty].Value)
+	require.NotContains(t, action.Properties, awsCloudFormationParametersRawProperty)
+	require.NotContains(t, action.Properties, awsAccountVariableProperty)
+	require.Equal(t, "True", action.Properties[awsUseInstanceRoleProperty].Value)
+	require.Len(t, action.Packages, 1)
+	require.Equal(t, "web-stack", action.Packages[0].PackageID)
+
+	state := flattenDeployAwsCloudFormationTemplateAction(action, &plan, 0)
+	require.True(t, state.InlineTemplate.IsNull())
+	require.True(t, state.Parameters.IsNull())
+	require.Equal(t, plan.TemplatePath, state.TemplatePath)
+	require.Equal(t, plan.ParametersFilePath, state.ParametersFilePath)
+	require.True(t, state.AwsAccount[0].Variable.IsNull())
+	require.Len(t, state.PrimaryPackage, 1)
+}
+
+func TestApplyAwsCloudFormationChangeSetActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Apply change set", "Octopus.AwsApplyCloudFormationChangeSet")
+	action.ID = "Actions-2"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:  "True",
+		awsAccountVariableProperty: "AWS.Account",
+		awsChangeSetArnProperty:    "#{AwsOutputs[ChangesetId]}",
+		awsRegionProperty:          "eu-west-1",
+		awsStackNameProperty:       "web",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+
+	imported := flattenApplyAwsCloudFormationChangeSetAction(action, nil, 0)
+	require.Equal(t, "#{AwsOutputs[ChangesetId]}", imported.ChangeSetName.ValueString())
+	require.True(t, imported.WaitForCompletion.ValueBool())
+	require.Empty(t, imported.AwsAccount[0].Role)
+
+	expanded := expandApplyAwsCloudFormationChangeSetAction(imported, action)
+	require.Equal(t, "True", expanded.Properties[awsWaitForCompletionProperty].Value)
+	delete(expanded.Properties, awsWaitForCompletionProperty)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+}
+
+func TestDeleteAwsCloudFormationStackActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Delete stack", "Octopus.AwsDeleteCloudFormation")
+	action.ID = "Actions-3"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:    "True",
+		awsRegionProperty:            "eu-west-1",
+		awsStackNameProperty:         "web",
+		awsUseInstanceRoleProperty:   "True",
+		awsWaitForCompletionProperty: "False",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+
+	imported := flattenDeleteAwsCloudFormationStackAction(action, nil, 0)
+	require.False(t, imported.WaitForCompletion.ValueBool())
+	require.True(t, imported.AwsAccount[0].UseInstanceRole.ValueBool())
+	require.True(t, imported.AwsAccount[0].Variable.IsNull())
+
+	expanded := expandDeleteAwsCloudFormationStackAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+
+	require.Equal(t, imported, flattenDeleteAwsCloudFormationStackAction(expanded, &imported, 0))
+}
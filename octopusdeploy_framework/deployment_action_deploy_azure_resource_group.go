@@ -0,0 +1,101 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that deploys an Azure Resource Manager template to a resource group.
+const (
+	azureDeploymentModeProperty     = "Octopus.Action.Azure.ResourceGroupDeploymentMode"
+	azureResourceGroupNameProperty  = "Octopus.Action.Azure.ResourceGroupName"
+	azureTemplateParametersProperty = "Octopus.Action.Azure.ResourceGroupTemplateParameters"
+	azureTemplateProperty           = "Octopus.Action.Azure.ResourceGroupTemplate"
+	azureTemplateSourceProperty     = "Octopus.Action.Azure.TemplateSource"
+)
+
+// azureResourceGroupTemplate names the properties that hold the template of an Azure resource group action.
+var azureResourceGroupTemplate = templateProperties{
+	source:     azureTemplateSourceProperty,
+	template:   azureTemplateProperty,
+	parameters: azureTemplateParametersProperty,
+}
+
+// azureTemplateParameter is a value of the JSON object in which Octopus Deploy stores the parameters of an inline
+// template, which is the parameters section of an Azure Resource Manager parameters file.
+type azureTemplateParameter struct {
+	Value json.RawMessage `json:"value"`
+}
+
+func expandDeployAzureResourceGroupAction(plan schemas.DeployAzureResourceGroupActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.AzureResourceGroup", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+
+	properties := plan.Properties
+	parameters := formatAzureTemplateParameters(expandStringMap(plan.Parameters))
+	expandTemplateSource(action, plan.TemplateSourceModel, properties, current, azureResourceGroupTemplate, parameters)
+
+	action.Properties[azureResourceGroupNameProperty] = newPropertyValue(plan.ResourceGroupName.ValueString())
+	setActionProperty(action, properties, azureDeploymentModeProperty, plan.DeploymentMode.ValueString())
+	if len(plan.AzureAccount) > 0 {
+		action.Properties[azureAccountVariableProperty] = newPropertyValue(plan.AzureAccount[0].Variable.ValueString())
+	}
+	return action
+}
+
+func formatAzureTemplateParameters(values map[string]string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	parameters := map[string]azureTemplateParameter{}
+	for key, value := range values {
+		parameter, _ := json.Marshal(value)
+		parameters[key] = azureTemplateParameter{Value: parameter}
+	}
+	value, _ := json.Marshal(parameters)
+	return string(value)
+}
+
+func flattenDeployAzureResourceGroupAction(action *deployments.DeploymentAction, prior *schemas.DeployAzureResourceGroupActionModel, position int) schemas.DeployAzureResourceGroupActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployAzureResourceGroupActionModel{}
+	}
+
+	deploymentMode := action.Properties[azureDeploymentModeProperty].Value
+	if deploymentMode == "" {
+		deploymentMode = "Incremental"
+	}
+
+	return schemas.DeployAzureResourceGroupActionModel{
+		DeploymentMode:    types.StringValue(deploymentMode),
+		ResourceGroupName: types.StringValue(action.Properties[azureResourceGroupNameProperty].Value),
+		AzureAccount: []schemas.TerraformTemplateAzureAccountModel{
+			{Variable: types.StringValue(action.Properties[azureAccountVariableProperty].Value)},
+		},
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		TemplateSourceModel:            flattenTemplateSource(action, prior.TemplateSourceModel, imported, azureResourceGroupTemplate, parseAzureTemplateParameters),
+	}
+}
+
+// parseAzureTemplateParameters returns the values of the parameters of an inline template. Values that are not
+// strings, which Octopus Deploy accepts from templates edited outside of Terraform, are returned as JSON.
+func parseAzureTemplateParameters(value string) map[string]string {
+	parameters := map[string]azureTemplateParameter{}
+	_ = json.Unmarshal([]byte(value), &parameters)
+
+	values := map[string]string{}
+	for key, parameter := range parameters {
+		var text string
+		if err := json.Unmarshal(parameter.Value, &text); err != nil {
+			text = string(parameter.Value)
+		}
+		values[key] = text
+	}
+	return values
+}
@@ -0,0 +1,77 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployAzureResourceGroupActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Deploy template", "Octopus.AzureResourceGroup")
+	action.ID = "Actions-1"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:       "True",
+		azureAccountVariableProperty:    "Azure.Account",
+		azureDeploymentModeProperty:     "Complete",
+		azureResourceGroupNameProperty:  "web-#{Octopus.Environment.Name}",
+		azureTemplateParametersProperty: `{"location":{"value":"australiaeast"},"sku":{"value":"Standard_LRS"}}`,
+		azureTemplateProperty:           `{"resources":[]}`,
+		azureTemplateSourceProperty:     manifestSourceInline,
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+
+	imported := flattenDeployAzureResourceGroupAction(action, nil, 0)
+	require.Equal(t, "Complete", imported.DeploymentMode.ValueString())
+	require.Equal(t, "Azure.Account", imported.AzureAccount[0].Variable.ValueString())
+	require.Equal(t, map[string]string{"location": "australiaeast", "sku": "Standard_LRS"}, expandStringMap(imported.Parameters))
+	require.True(t, imported.ParametersFilePath.IsNull())
+
+	expanded := expandDeployAzureResourceGroupAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+
+	require.Equal(t, imported, flattenDeployAzureResourceGroupAction(expanded, &imported, 0))
+}
+
+func TestDeployAzureResourceGroupActionRoundTripsPackageImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Deploy template", "Octopus.AzureResourceGroup")
+	action.ID = "Actions-2"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:       "True",
+		azureAccountVariableProperty:    "Azure.Account",
+		azureResourceGroupNameProperty:  "web",
+		azureTemplateParametersProperty: "parameters.json",
+		azureTemplateProperty:           "azuredeploy.json",
+		azureTemplateSourceProperty:     manifestSourcePackage,
+		primaryPackageDownloadProperty:  "False",
+		primaryPackageFeedIDProperty:    "Feeds-1",
+		primaryPackageIDProperty:        "web-templates",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+	action.Packages = []*packages.PackageReference{
+		{AcquisitionLocation: "Server", FeedID: "Feeds-1", ID: "Packages-1", PackageID: "web-templates", Properties: map[string]string{}},
+	}
+
+	imported := flattenDeployAzureResourceGroupAction(action, nil, 0)
+	require.Equal(t, "Incremental", imported.DeploymentMode.ValueString())
+	require.Equal(t, "azuredeploy.json", imported.TemplatePath.ValueString())
+	require.Equal(t, "parameters.json", imported.ParametersFilePath.ValueString())
+	require.True(t, imported.InlineTemplate.IsNull())
+	require.True(t, imported.Parameters.IsNull())
+	require.Len(t, imported.PrimaryPackage, 1)
+
+	expanded := expandDeployAzureResourceGroupAction(imported, action)
+	require.Equal(t, "Incremental", expanded.Properties[azureDeploymentModeProperty].Value)
+	delete(expanded.Properties, azureDeploymentModeProperty)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+	require.Equal(t, action.Packages, expanded.Packages)
+}
+
+func TestParseAzureTemplateParameters(t *testing.T) {
+	values := parseAzureTemplateParameters(`{"name":{"value":"web"},"count":{"value":3},"tags":{"value":{"team":"web"}}}`)
+	require.Equal(t, map[string]string{"name": "web", "count": "3", "tags": `{"team":"web"}`}, values)
+	require.Empty(t, parseAzureTemplateParameters(""))
+}
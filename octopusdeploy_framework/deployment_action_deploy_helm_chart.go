@@ -0,0 +1,118 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that upgrades a Helm chart.
+const (
+	helmClientVersionProperty  = "Octopus.Action.Helm.ClientVersion"
+	helmKeyValuesProperty      = "Octopus.Action.Helm.KeyValues"
+	helmNamespaceProperty      = "Octopus.Action.Helm.Namespace"
+	helmReleaseNameProperty    = "Octopus.Action.Helm.ReleaseName"
+	helmResetValuesProperty    = "Octopus.Action.Helm.ResetValues"
+	helmTimeoutProperty        = "Octopus.Action.Helm.Timeout"
+	helmYamlValuesProperty     = "Octopus.Action.Helm.YamlValues"
+	helmValuesFilePathProperty = "ValuesFilePath"
+)
+
+func expandDeployHelmChartAction(plan schemas.DeployHelmChartActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.HelmChartUpgrade", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandHelmValuesPackages(action, plan.ValuesPackages, current)
+
+	action.Properties[helmReleaseNameProperty] = newPropertyValue(plan.ReleaseName.ValueString())
+	setActionProperty(action, plan.Properties, helmNamespaceProperty, plan.Namespace.ValueString())
+	setActionProperty(action, plan.Properties, helmYamlValuesProperty, plan.YamlValues.ValueString())
+	setActionProperty(action, plan.Properties, helmTimeoutProperty, plan.Timeout.ValueString())
+	setActionProperty(action, plan.Properties, helmClientVersionProperty, plan.HelmClientVersion.ValueString())
+	setActionBoolProperty(action, plan.Properties, helmResetValuesProperty, plan.ResetValues)
+
+	keyValues := ""
+	if !plan.KeyValues.IsNull() && !plan.KeyValues.IsUnknown() {
+		// Marshalling a map sorts its keys, so the value only changes when the key values do.
+		b, _ := json.Marshal(expandStringMap(plan.KeyValues))
+		keyValues = string(b)
+	}
+	setActionProperty(action, plan.Properties, helmKeyValuesProperty, keyValues)
+	return action
+}
+
+// expandHelmValuesPackages adds the packages that hold values files. Their contents are extracted, as the values files
+// are read from them.
+func expandHelmValuesPackages(action *deployments.DeploymentAction, plan []schemas.HelmValuesPackageModel, current *deployments.DeploymentAction) {
+	if len(plan) == 0 {
+		action.Packages = append(action.Packages, otherPackageReferences(current, true)...)
+		return
+	}
+
+	for _, model := range plan {
+		reference := expandPackageReference(model.PackageReferenceModel, findPackageReference(current, model.Name.ValueString()))
+		reference.Properties[helmValuesFilePathProperty] = model.ValuesFilePath.ValueString()
+		if _, ok := reference.Properties[packageReferenceExtractProperty]; !ok {
+			reference.Properties[packageReferenceExtractProperty] = formatBoolProperty(true)
+		}
+		action.Packages = append(action.Packages, reference)
+	}
+}
+
+func flattenDeployHelmChartAction(action *deployments.DeploymentAction, prior *schemas.DeployHelmChartActionModel, position int) schemas.DeployHelmChartActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployHelmChartActionModel{}
+	}
+
+	// Octopus Deploy resets the values of a release unless the action says otherwise.
+	resetValues := true
+	if v, ok := action.Properties[helmResetValuesProperty]; ok && v.Value != "" {
+		resetValues = parseBoolProperty(v.Value)
+	}
+
+	return schemas.DeployHelmChartActionModel{
+		HelmClientVersion:              flattenActionString(action.Properties[helmClientVersionProperty].Value, prior.HelmClientVersion, imported),
+		KeyValues:                      flattenHelmKeyValues(action.Properties[helmKeyValuesProperty].Value, prior.KeyValues, imported),
+		Namespace:                      flattenActionString(action.Properties[helmNamespaceProperty].Value, prior.Namespace, imported),
+		ReleaseName:                    types.StringValue(action.Properties[helmReleaseNameProperty].Value),
+		ResetValues:                    types.BoolValue(resetValues),
+		Timeout:                        flattenActionString(action.Properties[helmTimeoutProperty].Value, prior.Timeout, imported),
+		YamlValues:                     flattenActionString(action.Properties[helmYamlValuesProperty].Value, prior.YamlValues, imported),
+		PrimaryPackage:                 flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		ValuesPackages:                 flattenHelmValuesPackages(action, prior.ValuesPackages, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+	}
+}
+
+// flattenHelmKeyValues returns the key values of a Helm chart action, which Octopus Deploy stores as a JSON object. An
+// imported action that has the property keeps it, even when it holds no key values, as expanding them writes "{}".
+func flattenHelmKeyValues(value string, prior types.Map, imported bool) types.Map {
+	keyValues := map[string]string{}
+	_ = json.Unmarshal([]byte(value), &keyValues)
+	if imported && value != "" {
+		return types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(keyValues))
+	}
+	return flattenActionMap(keyValues, prior, imported)
+}
+
+func flattenHelmValuesPackages(action *deployments.DeploymentAction, prior []schemas.HelmValuesPackageModel, imported bool) []schemas.HelmValuesPackageModel {
+	priorPackages := make([]schemas.PackageReferenceModel, len(prior))
+	for i, p := range prior {
+		priorPackages[i] = p.PackageReferenceModel
+	}
+
+	valuesPackages := []schemas.HelmValuesPackageModel{}
+	for _, reference := range flattenPackages(action, priorPackages, imported, true) {
+		valuesFilePath := findPackageReference(action, reference.Name.ValueString()).Properties[helmValuesFilePathProperty]
+		valuesPackages = append(valuesPackages, schemas.HelmValuesPackageModel{
+			ValuesFilePath:        types.StringValue(valuesFilePath),
+			PackageReferenceModel: reference,
+		})
+	}
+	return valuesPackages
+}
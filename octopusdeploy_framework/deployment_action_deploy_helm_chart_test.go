@@ -0,0 +1,88 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployHelmChartActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Upgrade", "Octopus.HelmChartUpgrade")
+	action.ID = "Actions-1"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:      "True",
+		helmClientVersionProperty:      "V3",
+		helmKeyValuesProperty:          `{"image.tag":"1.2.3","replicas":"2"}`,
+		helmNamespaceProperty:          "web",
+		helmReleaseNameProperty:        "web-release",
+		helmResetValuesProperty:        "False",
+		helmTimeoutProperty:            "5m0s",
+		helmYamlValuesProperty:         "ingress:\n  enabled: true\n",
+		primaryPackageDownloadProperty: "False",
+		primaryPackageFeedIDProperty:   "Feeds-1",
+		primaryPackageIDProperty:       "web-chart",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+	action.Packages = []*packages.PackageReference{
+		{AcquisitionLocation: "Server", FeedID: "Feeds-1", ID: "Packages-1", PackageID: "web-chart", Properties: map[string]string{}},
+		{AcquisitionLocation: "Server", FeedID: "Feeds-2", ID: "Packages-2", Name: "values", PackageID: "web-values", Properties: map[string]string{
+			packageReferenceExtractProperty: "True",
+			helmValuesFilePathProperty:      "production.yaml",
+		}},
+	}
+
+	imported := flattenDeployHelmChartAction(action, nil, 0)
+	require.Equal(t, "web-release", imported.ReleaseName.ValueString())
+	require.Equal(t, "web", imported.Namespace.ValueString())
+	require.False(t, imported.ResetValues.ValueBool())
+	require.Equal(t, map[string]string{"image.tag": "1.2.3", "replicas": "2"}, expandStringMap(imported.KeyValues))
+	require.Len(t, imported.PrimaryPackage, 1)
+	require.Len(t, imported.ValuesPackages, 1)
+	require.Equal(t, "production.yaml", imported.ValuesPackages[0].ValuesFilePath.ValueString())
+
+	expanded := expandDeployHelmChartAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+	require.Equal(t, action.Packages, expanded.Packages)
+
+	require.Equal(t, imported, flattenDeployHelmChartAction(expanded, &imported, 0))
+}
+
+func TestDeployHelmChartActionOnlyManagesConfiguredProperties(t *testing.T) {
+	action := deployments.NewDeploymentAction("Upgrade", "Octopus.HelmChartUpgrade")
+	action.Properties[helmReleaseNameProperty] = newPropertyValue("web-release")
+	action.Properties[helmTimeoutProperty] = newPropertyValue("10m0s")
+
+	prior := schemas.DeployHelmChartActionModel{
+		KeyValues:   types.MapNull(types.StringType),
+		Namespace:   types.StringNull(),
+		ReleaseName: types.StringValue("web-release"),
+		Timeout:     types.StringNull(),
+	}
+	prior.Name = types.StringValue("Upgrade")
+
+	state := flattenDeployHelmChartAction(action, &prior, 0)
+	require.True(t, state.Timeout.IsNull())
+	require.True(t, state.KeyValues.IsNull())
+	require.True(t, state.ResetValues.ValueBool())
+	require.Empty(t, state.ValuesPackages)
+}
+
+func TestFlattenHelmKeyValuesKeepsEmptyObjectOnImport(t *testing.T) {
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	require.Equal(t, empty, flattenHelmKeyValues("{}", types.MapNull(types.StringType), true))
+	require.True(t, flattenHelmKeyValues("", types.MapNull(types.StringType), true).IsNull())
+	require.True(t, flattenHelmKeyValues("{}", types.MapNull(types.StringType), false).IsNull())
+	require.Equal(t, empty, flattenHelmKeyValues("{}", empty, false))
+
+	action := deployments.NewDeploymentAction("Upgrade", "Octopus.HelmChartUpgrade")
+	action.Properties[helmKeyValuesProperty] = newPropertyValue("{}")
+	imported := flattenDeployHelmChartAction(action, nil, 0)
+	expanded := expandDeployHelmChartAction(imported, action)
+	require.Equal(t, "{}", expanded.Properties[helmKeyValuesProperty].Value)
+}
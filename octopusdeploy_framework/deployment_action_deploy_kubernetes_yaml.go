@@ -0,0 +1,121 @@
+package octopusdeploy_framework
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of the actions that apply Kubernetes manifests.
+const (
+	kubernetesCustomResourceYamlProperty     = "Octopus.Action.KubernetesContainers.CustomResourceYaml"
+	kubernetesCustomResourceFileNameProperty = "Octopus.Action.KubernetesContainers.CustomResourceYamlFileName"
+	kubernetesForceConflictsProperty         = "Octopus.Action.Kubernetes.ServerSideApply.ForceConflicts"
+	kubernetesServerSideApplyProperty        = "Octopus.Action.Kubernetes.ServerSideApply.Enabled"
+	kubernetesStatusCheckTimeoutProperty     = "Octopus.Action.Kubernetes.DeploymentTimeout"
+	kubernetesWaitForJobsProperty            = "Octopus.Action.Kubernetes.WaitForJobs"
+	structuredVariablesEnabledProperty       = "Octopus.Action.Package.JsonConfigurationVariablesEnabled"
+	structuredVariablesFeature               = "Octopus.Features.JsonConfigurationVariables"
+	structuredVariablesTargetsProperty       = "Octopus.Action.Package.JsonConfigurationVariablesTargets"
+)
+
+// The sources of the manifests of an action, stored in its script source property.
+const (
+	manifestSourceGitRepository = "GitRepository"
+	manifestSourceInline        = "Inline"
+	manifestSourcePackage       = "Package"
+)
+
+func expandDeployKubernetesYamlAction(plan schemas.DeployKubernetesYamlActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.KubernetesDeployRawYaml", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandKubernetesManifestAction(action, plan.KubernetesManifestActionModel, plan.DeploymentActionBaseModel, current, manifestSourceInline)
+
+	setActionProperty(action, plan.Properties, kubernetesCustomResourceYamlProperty, plan.InlineYaml.ValueString())
+	setActionProperty(action, plan.Properties, kubernetesCustomResourceFileNameProperty, strings.Join(util.ExpandStringList(plan.YamlFilePaths), "\n"))
+	setActionProperty(action, plan.Properties, kubernetesNamespaceProperty, plan.Namespace.ValueString())
+	return action
+}
+
+// expandKubernetesManifestAction applies the attributes of an action that applies Kubernetes manifests. The manifests
+// come from either the primary package or the git dependency, so the other source is removed; when neither is
+// configured they come from inlineSource, if the action block has one.
+func expandKubernetesManifestAction(action *deployments.DeploymentAction, plan schemas.KubernetesManifestActionModel, base schemas.DeploymentActionBaseModel, current *deployments.DeploymentAction, inlineSource string) {
+	source := inlineSource
+	switch {
+	case len(plan.PrimaryPackage) > 0:
+		source = manifestSourcePackage
+		expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	case len(base.GitDependency) > 0:
+		source = manifestSourceGitRepository
+	}
+	if source != manifestSourceGitRepository {
+		action.GitDependencies = nil
+	}
+	if source != "" {
+		action.Properties[scriptSourceProperty] = newPropertyValue(source)
+	}
+
+	setActionBoolProperty(action, base.Properties, kubernetesStatusCheckProperty, plan.KubernetesObjectStatusCheckEnabled)
+	setActionBoolProperty(action, base.Properties, kubernetesWaitForJobsProperty, plan.KubernetesObjectStatusWaitForJobs)
+	setActionBoolProperty(action, base.Properties, kubernetesServerSideApplyProperty, plan.ServerSideApplyEnabled)
+	setActionBoolProperty(action, base.Properties, kubernetesForceConflictsProperty, plan.ServerSideApplyForceConflicts)
+	if !plan.KubernetesObjectStatusTimeout.IsNull() && !plan.KubernetesObjectStatusTimeout.IsUnknown() {
+		action.Properties[kubernetesStatusCheckTimeoutProperty] = newPropertyValue(strconv.FormatInt(plan.KubernetesObjectStatusTimeout.ValueInt64(), 10))
+	} else {
+		setActionProperty(action, base.Properties, kubernetesStatusCheckTimeoutProperty, "")
+	}
+
+	if targets := util.ExpandStringList(plan.StructuredVariableSubstitutionFiles); len(targets) > 0 {
+		action.Properties[structuredVariablesTargetsProperty] = newPropertyValue(strings.Join(targets, "\n"))
+		action.Properties[structuredVariablesEnabledProperty] = newPropertyValue(formatBoolProperty(true))
+		addActionFeature(action, structuredVariablesFeature)
+	} else {
+		setActionProperty(action, base.Properties, structuredVariablesTargetsProperty, "")
+	}
+}
+
+func flattenDeployKubernetesYamlAction(action *deployments.DeploymentAction, prior *schemas.DeployKubernetesYamlActionModel, position int) schemas.DeployKubernetesYamlActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployKubernetesYamlActionModel{}
+	}
+
+	return schemas.DeployKubernetesYamlActionModel{
+		InlineYaml:                     flattenActionString(action.Properties[kubernetesCustomResourceYamlProperty].Value, prior.InlineYaml, imported),
+		Namespace:                      flattenActionString(action.Properties[kubernetesNamespaceProperty].Value, prior.Namespace, imported),
+		YamlFilePaths:                  flattenActionLines(action.Properties[kubernetesCustomResourceFileNameProperty].Value, prior.YamlFilePaths, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		KubernetesManifestActionModel:  flattenKubernetesManifestAction(action, prior.KubernetesManifestActionModel, imported),
+	}
+}
+
+func flattenKubernetesManifestAction(action *deployments.DeploymentAction, prior schemas.KubernetesManifestActionModel, imported bool) schemas.KubernetesManifestActionModel {
+	return schemas.KubernetesManifestActionModel{
+		KubernetesObjectStatusCheckEnabled:  types.BoolValue(parseBoolProperty(action.Properties[kubernetesStatusCheckProperty].Value)),
+		KubernetesObjectStatusTimeout:       flattenActionInt64(action.Properties[kubernetesStatusCheckTimeoutProperty].Value, prior.KubernetesObjectStatusTimeout, imported),
+		KubernetesObjectStatusWaitForJobs:   flattenActionBool(action.Properties[kubernetesWaitForJobsProperty].Value, prior.KubernetesObjectStatusWaitForJobs, imported),
+		ServerSideApplyEnabled:              flattenActionBool(action.Properties[kubernetesServerSideApplyProperty].Value, prior.ServerSideApplyEnabled, imported),
+		ServerSideApplyForceConflicts:       flattenActionBool(action.Properties[kubernetesForceConflictsProperty].Value, prior.ServerSideApplyForceConflicts, imported),
+		StructuredVariableSubstitutionFiles: flattenActionLines(action.Properties[structuredVariablesTargetsProperty].Value, prior.StructuredVariableSubstitutionFiles, imported),
+		PrimaryPackage:                      flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+	}
+}
+
+// flattenActionLines returns an optional list attribute of an action block that models a property holding one value
+// per line, following the rules of flattenActionString.
+func flattenActionLines(value string, prior types.List, imported bool) types.List {
+	var lines []string
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return flattenActionList(lines, prior, imported)
+}
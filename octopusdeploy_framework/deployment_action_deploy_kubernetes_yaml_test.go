@@ -0,0 +1,120 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/gitdependencies"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployKubernetesYamlActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Apply", "Octopus.KubernetesDeployRawYaml")
+	action.ID = "Actions-1"
+	for k, v := range map[string]string{
+		actionEnabledFeaturesProperty:            structuredVariablesFeature,
+		actionRunOnServerProperty:                "True",
+		kubernetesCustomResourceFileNameProperty: "deploy/*.yaml\nservices/*.yaml",
+		kubernetesForceConflictsProperty:         "True",
+		kubernetesNamespaceProperty:              "web",
+		kubernetesServerSideApplyProperty:        "True",
+		kubernetesStatusCheckProperty:            "True",
+		kubernetesStatusCheckTimeoutProperty:     "300",
+		kubernetesWaitForJobsProperty:            "False",
+		scriptSourceProperty:                     manifestSourceGitRepository,
+		structuredVariablesEnabledProperty:       "True",
+		structuredVariablesTargetsProperty:       "deploy/config.yaml",
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+	action.GitDependencies = []*gitdependencies.GitDependency{{
+		RepositoryUri:     "https://example.com/web.git",
+		DefaultBranch:     "main",
+		GitCredentialType: "Anonymous",
+		FilePathFilters:   []string{"deploy/*.yaml"},
+	}}
+
+	imported := flattenDeployKubernetesYamlAction(action, nil, 0)
+	require.True(t, imported.InlineYaml.IsNull())
+	require.Equal(t, []string{"deploy/*.yaml", "services/*.yaml"}, util.ExpandStringList(imported.YamlFilePaths))
+	require.Equal(t, int64(300), imported.KubernetesObjectStatusTimeout.ValueInt64())
+	require.False(t, imported.KubernetesObjectStatusWaitForJobs.ValueBool())
+	require.Equal(t, []string{"deploy/config.yaml"}, util.ExpandStringList(imported.StructuredVariableSubstitutionFiles))
+	require.Len(t, imported.GitDependency, 1)
+	require.Empty(t, imported.PrimaryPackage)
+
+	expanded := expandDeployKubernetesYamlAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+	require.Equal(t, action.GitDependencies, expanded.GitDependencies)
+	require.Empty(t, expanded.Packages)
+
+	require.Equal(t, imported, flattenDeployKubernetesYamlAction(expanded, &imported, 0))
+}
+
+func TestDeployKubernetesYamlActionIgnoresServerDefaults(t *testing.T) {
+	plan := schemas.DeployKubernetesYamlActionModel{
+		InlineYaml:    types.StringValue("apiVersion: v1\nkind: ConfigMap\n"),
+		Namespace:     types.StringNull(),
+		YamlFilePaths: types.ListNull(types.StringType),
+		KubernetesManifestActionModel: schemas.KubernetesManifestActionModel{
+			KubernetesObjectStatusCheckEnabled:  types.BoolValue(true),
+			KubernetesObjectStatusTimeout:       types.Int64Null(),
+			KubernetesObjectStatusWaitForJobs:   types.BoolNull(),
+			ServerSideApplyEnabled:              types.BoolNull(),
+			ServerSideApplyForceConflicts:       types.BoolNull(),
+			StructuredVariableSubstitutionFiles: types.ListNull(types.StringType),
+		},
+	}
+	plan.Name = types.StringValue("Apply")
+	plan.Properties = types.MapUnknown(types.StringType)
+
+	action := expandDeployKubernetesYamlAction(plan, nil)
+	require.Equal(t, manifestSourceInline, action.Properties[scriptSourceProperty].Value)
+	require.Equal(t, "True", action.Properties[kubernetesStatusCheckProperty].Value)
+
+	// Octopus Deploy adds the default settings of the object status check when the action is saved.
+	action.Properties[kubernetesStatusCheckTimeoutProperty] = newPropertyValue("180")
+	action.Properties[kubernetesWaitForJobsProperty] = newPropertyValue("False")
+	action.Properties[kubernetesServerSideApplyProperty] = newPropertyValue("True")
+
+	state := flattenDeployKubernetesYamlAction(action, &plan, 0)
+	require.True(t, state.KubernetesObjectStatusTimeout.IsNull())
+	require.True(t, state.KubernetesObjectStatusWaitForJobs.IsNull())
+	require.True(t, state.ServerSideApplyEnabled.IsNull())
+	require.Equal(t, plan.InlineYaml, state.InlineYaml)
+}
+
+func TestDeployKustomizeActionRoundTripsImports(t *testing.T) {
+	action := deployments.NewDeploymentAction("Kustomize", "Octopus.Kustomize")
+	action.ID = "Actions-2"
+	for k, v := range map[string]string{
+		actionRunOnServerProperty:      "True",
+		kubernetesStatusCheckProperty:  "False",
+		kustomizeOverlayPathProperty:   "overlays/#{Octopus.Environment.Name}",
+		primaryPackageDownloadProperty: "False",
+		primaryPackageFeedIDProperty:   "Feeds-1",
+		primaryPackageIDProperty:       "web-manifests",
+		scriptSourceProperty:           manifestSourcePackage,
+	} {
+		action.Properties[k] = newPropertyValue(v)
+	}
+	action.Packages = []*packages.PackageReference{
+		{AcquisitionLocation: "Server", FeedID: "Feeds-1", ID: "Packages-1", PackageID: "web-manifests", Properties: map[string]string{}},
+	}
+
+	imported := flattenDeployKustomizeAction(action, nil, 0)
+	require.Equal(t, "overlays/#{Octopus.Environment.Name}", imported.OverlayPath.ValueString())
+	require.False(t, imported.KubernetesObjectStatusCheckEnabled.ValueBool())
+	require.True(t, imported.KubernetesObjectStatusTimeout.IsNull())
+	require.Len(t, imported.PrimaryPackage, 1)
+
+	expanded := expandDeployKustomizeAction(imported, action)
+	require.Equal(t, flattenPropertyValues(action.Properties), flattenPropertyValues(expanded.Properties))
+	require.Equal(t, action.Packages, expanded.Packages)
+
+	require.Equal(t, imported, flattenDeployKustomizeAction(expanded, &imported, 0))
+}
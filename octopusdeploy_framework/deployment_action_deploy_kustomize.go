@@ -0,0 +1,31 @@
+package octopusdeploy_framework
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const kustomizeOverlayPathProperty = "Octopus.Action.Kubernetes.Kustomize.OverlayPath"
+
+func expandDeployKustomizeAction(plan schemas.DeployKustomizeActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.Kustomize", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandKubernetesManifestAction(action, plan.KubernetesManifestActionModel, plan.DeploymentActionBaseModel, current, "")
+	action.Properties[kustomizeOverlayPathProperty] = newPropertyValue(plan.OverlayPath.ValueString())
+	return action
+}
+
+func flattenDeployKustomizeAction(action *deployments.DeploymentAction, prior *schemas.DeployKustomizeActionModel, position int) schemas.DeployKustomizeActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployKustomizeActionModel{}
+	}
+
+	return schemas.DeployKustomizeActionModel{
+		OverlayPath:                    types.StringValue(action.Properties[kustomizeOverlayPathProperty].Value),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		KubernetesManifestActionModel:  flattenKubernetesManifestAction(action, prior.KubernetesManifestActionModel, imported),
+	}
+}
@@ -0,0 +1,42 @@
+package octopusdeploy_framework
+
+import (
+	"slices"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+)
+
+func expandDeployPackageAction(plan schemas.DeployPackageActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.TentaclePackage", plan.DeploymentActionBaseModel, current)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandPackages(action, plan.Packages, current, true)
+	if len(plan.WindowsService) > 0 {
+		expandWindowsService(action, plan.WindowsService[0], plan.Properties)
+	}
+	return action
+}
+
+func flattenDeployPackageAction(action *deployments.DeploymentAction, prior *schemas.DeployPackageActionModel, position int) schemas.DeployPackageActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployPackageActionModel{}
+	}
+
+	state := schemas.DeployPackageActionModel{
+		PrimaryPackage:            flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                  flattenPackages(action, prior.Packages, imported, true),
+		WindowsService:            []schemas.WindowsServiceModel{},
+		DeploymentActionBaseModel: flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+	}
+
+	features := splitActionFeatures(action.Properties[actionEnabledFeaturesProperty].Value)
+	if slices.Contains(features, windowsServiceFeature) && flattenBlock(imported, len(prior.WindowsService) > 0) {
+		var priorService schemas.WindowsServiceModel
+		if len(prior.WindowsService) > 0 {
+			priorService = prior.WindowsService[0]
+		}
+		state.WindowsService = append(state.WindowsService, flattenWindowsService(action, priorService, imported))
+	}
+	return state
+}
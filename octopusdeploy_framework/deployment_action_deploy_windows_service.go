@@ -0,0 +1,92 @@
+package octopusdeploy_framework
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that deploys a Windows service.
+const (
+	windowsServiceArgumentsProperty             = "Octopus.Action.WindowsService.Arguments"
+	windowsServiceCreateOrUpdateServiceProperty = "Octopus.Action.WindowsService.CreateOrUpdateService"
+	windowsServiceCustomAccountNameProperty     = "Octopus.Action.WindowsService.CustomAccountName"
+	windowsServiceCustomAccountPasswordProperty = "Octopus.Action.WindowsService.CustomAccountPassword"
+	windowsServiceDependenciesProperty          = "Octopus.Action.WindowsService.Dependencies"
+	windowsServiceDescriptionProperty           = "Octopus.Action.WindowsService.Description"
+	windowsServiceDisplayNameProperty           = "Octopus.Action.WindowsService.DisplayName"
+	windowsServiceExecutablePathProperty        = "Octopus.Action.WindowsService.ExecutablePath"
+	windowsServiceServiceAccountProperty        = "Octopus.Action.WindowsService.ServiceAccount"
+	windowsServiceServiceNameProperty           = "Octopus.Action.WindowsService.ServiceName"
+	windowsServiceStartModeProperty             = "Octopus.Action.WindowsService.StartMode"
+)
+
+func expandDeployWindowsServiceAction(plan schemas.DeployWindowsServiceActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.WindowsService", plan.DeploymentActionBaseModel, current)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandPackages(action, plan.Packages, current, true)
+	expandWindowsService(action, plan.WindowsServiceModel, plan.Properties)
+	return action
+}
+
+func flattenDeployWindowsServiceAction(action *deployments.DeploymentAction, prior *schemas.DeployWindowsServiceActionModel, position int) schemas.DeployWindowsServiceActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployWindowsServiceActionModel{}
+	}
+
+	return schemas.DeployWindowsServiceActionModel{
+		PrimaryPackage:            flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                  flattenPackages(action, prior.Packages, imported, true),
+		DeploymentActionBaseModel: flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		WindowsServiceModel:       flattenWindowsService(action, prior.WindowsServiceModel, imported),
+	}
+}
+
+// expandWindowsService applies the attributes of a Windows service, and enables the Windows service feature of the
+// action.
+func expandWindowsService(action *deployments.DeploymentAction, plan schemas.WindowsServiceModel, properties types.Map) {
+	addActionFeature(action, windowsServiceFeature)
+
+	setActionBoolProperty(action, properties, windowsServiceCreateOrUpdateServiceProperty, plan.CreateOrUpdateService)
+	action.Properties[windowsServiceServiceNameProperty] = newPropertyValue(plan.ServiceName.ValueString())
+	action.Properties[windowsServiceExecutablePathProperty] = newPropertyValue(plan.ExecutablePath.ValueString())
+	action.Properties[windowsServiceServiceAccountProperty] = newPropertyValue(plan.ServiceAccount.ValueString())
+	action.Properties[windowsServiceStartModeProperty] = newPropertyValue(plan.StartMode.ValueString())
+	setActionProperty(action, properties, windowsServiceDisplayNameProperty, plan.DisplayName.ValueString())
+	setActionProperty(action, properties, windowsServiceDescriptionProperty, plan.Description.ValueString())
+	setActionProperty(action, properties, windowsServiceArgumentsProperty, plan.Arguments.ValueString())
+	setActionProperty(action, properties, windowsServiceCustomAccountNameProperty, plan.CustomAccountName.ValueString())
+	setActionProperty(action, properties, windowsServiceDependenciesProperty, plan.Dependencies.ValueString())
+
+	// The password is read back as a sensitive value without its value, so it is only sent when it is configured.
+	if password := plan.CustomAccountPassword.ValueString(); password != "" {
+		action.Properties[windowsServiceCustomAccountPasswordProperty] = core.NewPropertyValue(password, true)
+	}
+}
+
+func flattenWindowsService(action *deployments.DeploymentAction, prior schemas.WindowsServiceModel, imported bool) schemas.WindowsServiceModel {
+	property := func(key string) string {
+		return action.Properties[key].Value
+	}
+
+	password := types.StringValue(property(windowsServiceCustomAccountPasswordProperty))
+	if action.Properties[windowsServiceCustomAccountPasswordProperty].IsSensitive && !prior.CustomAccountPassword.IsNull() && !prior.CustomAccountPassword.IsUnknown() {
+		password = prior.CustomAccountPassword
+	}
+
+	return schemas.WindowsServiceModel{
+		Arguments:             flattenActionString(property(windowsServiceArgumentsProperty), prior.Arguments, imported),
+		CreateOrUpdateService: types.BoolValue(parseBoolProperty(property(windowsServiceCreateOrUpdateServiceProperty))),
+		CustomAccountName:     flattenActionString(property(windowsServiceCustomAccountNameProperty), prior.CustomAccountName, imported),
+		CustomAccountPassword: password,
+		Dependencies:          flattenActionString(property(windowsServiceDependenciesProperty), prior.Dependencies, imported),
+		Description:           flattenActionString(property(windowsServiceDescriptionProperty), prior.Description, imported),
+		DisplayName:           flattenActionString(property(windowsServiceDisplayNameProperty), prior.DisplayName, imported),
+		ExecutablePath:        types.StringValue(property(windowsServiceExecutablePathProperty)),
+		ServiceAccount:        types.StringValue(property(windowsServiceServiceAccountProperty)),
+		ServiceName:           types.StringValue(property(windowsServiceServiceNameProperty)),
+		StartMode:             types.StringValue(property(windowsServiceStartModeProperty)),
+	}
+}
@@ -0,0 +1,48 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that deploys a Kubernetes secret.
+const (
+	kubernetesSecretNameProperty   = "Octopus.Action.KubernetesContainers.SecretName"
+	kubernetesSecretValuesProperty = "Octopus.Action.KubernetesContainers.SecretValues"
+)
+
+func expandDeployKubernetesSecretAction(plan schemas.DeployKubernetesSecretActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.KubernetesDeploySecret", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPackages(action, plan.Packages, current, false)
+
+	action.Properties[kubernetesSecretNameProperty] = newPropertyValue(plan.SecretName.ValueString())
+	// Marshalling a map sorts its keys, so the value only changes when the secret values do.
+	secretValues, _ := json.Marshal(expandStringMap(plan.SecretValues))
+	action.Properties[kubernetesSecretValuesProperty] = newPropertyValue(string(secretValues))
+	setActionBoolProperty(action, plan.Properties, kubernetesStatusCheckProperty, plan.KubernetesObjectStatusCheckEnabled)
+	return action
+}
+
+func flattenDeployKubernetesSecretAction(action *deployments.DeploymentAction, prior *schemas.DeployKubernetesSecretActionModel, position int) schemas.DeployKubernetesSecretActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.DeployKubernetesSecretActionModel{}
+	}
+
+	secretValues := map[string]string{}
+	_ = json.Unmarshal([]byte(action.Properties[kubernetesSecretValuesProperty].Value), &secretValues)
+
+	return schemas.DeployKubernetesSecretActionModel{
+		SecretName:                         types.StringValue(action.Properties[kubernetesSecretNameProperty].Value),
+		SecretValues:                       types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(secretValues)),
+		KubernetesObjectStatusCheckEnabled: types.BoolValue(parseBoolProperty(action.Properties[kubernetesStatusCheckProperty].Value)),
+		Packages:                           flattenPackages(action, prior.Packages, imported, false),
+		DeploymentActionBaseModel:          flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel:     flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+	}
+}
@@ -0,0 +1,60 @@
+package octopusdeploy_framework
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that waits for a user to act.
+const (
+	manualInstructionsProperty     = "Octopus.Action.Manual.Instructions"
+	manualResponsibleTeamsProperty = "Octopus.Action.Manual.ResponsibleTeamIds"
+	manualBlockDeploymentsProperty = "Octopus.Action.Manual.BlockConcurrentDeployments"
+)
+
+func expandManualInterventionAction(plan schemas.ManualInterventionActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.Manual", plan.DeploymentActionBaseModel, current)
+	expandPackages(action, plan.Packages, current, false)
+
+	action.Properties[manualInstructionsProperty] = newPropertyValue(plan.Instructions.ValueString())
+	setActionProperty(action, plan.Properties, manualResponsibleTeamsProperty, plan.ResponsibleTeams.ValueString())
+	setActionProperty(action, plan.Properties, manualBlockDeploymentsProperty, formatBoolOrExpressionProperty(plan.BlockDeployments.ValueString()))
+	return action
+}
+
+func flattenManualInterventionAction(action *deployments.DeploymentAction, prior *schemas.ManualInterventionActionModel, position int) schemas.ManualInterventionActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.ManualInterventionActionModel{}
+	}
+
+	// The value is stored as "True" or "False", so a configured "true" is kept as it is.
+	blockDeployments := action.Properties[manualBlockDeploymentsProperty].Value
+	if !prior.BlockDeployments.IsNull() && !prior.BlockDeployments.IsUnknown() && formatBoolOrExpressionProperty(prior.BlockDeployments.ValueString()) == blockDeployments {
+		blockDeployments = prior.BlockDeployments.ValueString()
+	}
+
+	return schemas.ManualInterventionActionModel{
+		Instructions:              types.StringValue(action.Properties[manualInstructionsProperty].Value),
+		ResponsibleTeams:          flattenActionString(action.Properties[manualResponsibleTeamsProperty].Value, prior.ResponsibleTeams, imported),
+		BlockDeployments:          flattenActionString(blockDeployments, prior.BlockDeployments, imported),
+		Packages:                  flattenPackages(action, prior.Packages, imported, false),
+		DeploymentActionBaseModel: flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+	}
+}
+
+// formatBoolOrExpressionProperty formats a value that is either a bool or an expression, such as #{BlockDeployments},
+// the way Octopus Deploy stores it in action properties.
+func formatBoolOrExpressionProperty(value string) string {
+	if strings.Contains(value, "#{") {
+		return value
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return formatBoolProperty(b)
+	}
+	return value
+}
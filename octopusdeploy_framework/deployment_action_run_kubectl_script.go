@@ -0,0 +1,34 @@
+package octopusdeploy_framework
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+)
+
+const kubernetesNamespaceProperty = "Octopus.Action.KubernetesContainers.Namespace"
+
+func expandRunKubectlScriptAction(plan schemas.RunKubectlScriptActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.KubernetesRunScript", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandNamedPackages(action, plan.Packages, current)
+	expandScriptAction(action, plan.ScriptActionModel, plan.Properties)
+	setActionProperty(action, plan.Properties, kubernetesNamespaceProperty, plan.Namespace.ValueString())
+	return action
+}
+
+func flattenRunKubectlScriptAction(action *deployments.DeploymentAction, prior *schemas.RunKubectlScriptActionModel, position int) schemas.RunKubectlScriptActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.RunKubectlScriptActionModel{}
+	}
+
+	return schemas.RunKubectlScriptActionModel{
+		Namespace:                      flattenActionString(action.Properties[kubernetesNamespaceProperty].Value, prior.Namespace, imported),
+		PrimaryPackage:                 flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                       flattenNamedPackages(action, prior.Packages, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		ScriptActionModel:              flattenScriptAction(action, prior.ScriptActionModel, imported),
+	}
+}
@@ -0,0 +1,72 @@
+package octopusdeploy_framework
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The properties of an action that runs a script.
+const (
+	scriptBodyProperty       = "Octopus.Action.Script.ScriptBody"
+	scriptFileNameProperty   = "Octopus.Action.Script.ScriptFileName"
+	scriptParametersProperty = "Octopus.Action.Script.ScriptParameters"
+	scriptSourceProperty     = "Octopus.Action.Script.ScriptSource"
+	scriptSyntaxProperty     = "Octopus.Action.Script.Syntax"
+)
+
+func expandRunScriptAction(plan schemas.RunScriptActionModel, current *deployments.DeploymentAction) *deployments.DeploymentAction {
+	action := expandActionBase("Octopus.Script", plan.DeploymentActionBaseModel, current)
+	expandActionExecution(action, plan.DeploymentActionExecutionModel)
+	expandPrimaryPackage(action, plan.PrimaryPackage, current)
+	expandNamedPackages(action, plan.Packages, current)
+	expandScriptAction(action, plan.ScriptActionModel, plan.Properties)
+	return action
+}
+
+func flattenRunScriptAction(action *deployments.DeploymentAction, prior *schemas.RunScriptActionModel, position int) schemas.RunScriptActionModel {
+	imported := prior == nil
+	if prior == nil {
+		prior = &schemas.RunScriptActionModel{}
+	}
+
+	return schemas.RunScriptActionModel{
+		PrimaryPackage:                 flattenPrimaryPackage(action, prior.PrimaryPackage, imported),
+		Packages:                       flattenNamedPackages(action, prior.Packages, imported),
+		DeploymentActionBaseModel:      flattenActionBase(action, priorActionBase(imported, prior.DeploymentActionBaseModel), position),
+		DeploymentActionExecutionModel: flattenActionExecution(action, prior.DeploymentActionExecutionModel),
+		ScriptActionModel:              flattenScriptAction(action, prior.ScriptActionModel, imported),
+	}
+}
+
+// expandScriptAction applies the attributes of an action that runs an inline script or a script from a package.
+func expandScriptAction(action *deployments.DeploymentAction, plan schemas.ScriptActionModel, properties types.Map) {
+	setActionProperty(action, properties, scriptBodyProperty, plan.ScriptBody.ValueString())
+	setActionProperty(action, properties, scriptFileNameProperty, plan.ScriptFileName.ValueString())
+	setActionProperty(action, properties, scriptParametersProperty, plan.ScriptParameters.ValueString())
+	if !plan.ScriptSource.IsUnknown() {
+		setActionProperty(action, properties, scriptSourceProperty, plan.ScriptSource.ValueString())
+	}
+	if !plan.ScriptSyntax.IsUnknown() {
+		setActionProperty(action, properties, scriptSyntaxProperty, plan.ScriptSyntax.ValueString())
+	}
+
+	if targetFiles := plan.VariableSubstitutionInFiles.ValueString(); targetFiles != "" {
+		action.Properties[substituteInFilesTargetsProperty] = newPropertyValue(targetFiles)
+		action.Properties[substituteInFilesEnabledProperty] = newPropertyValue(formatBoolProperty(true))
+		addActionFeature(action, substituteInFilesFeature)
+	} else {
+		setActionProperty(action, properties, substituteInFilesTargetsProperty, "")
+	}
+}
+
+func flattenScriptAction(action *deployments.DeploymentAction, prior schemas.ScriptActionModel, imported bool) schemas.ScriptActionModel {
+	return schemas.ScriptActionModel{
+		ScriptBody:                  flattenActionString(action.Properties[scriptBodyProperty].Value, prior.ScriptBody, imported),
+		ScriptFileName:              flattenActionString(action.Properties[scriptFileNameProperty].Value, prior.ScriptFileName, imported),
+		ScriptParameters:            flattenActionString(action.Properties[scriptParametersProperty].Value, prior.ScriptParameters, imported),
+		ScriptSource:                types.StringValue(action.Properties[scriptSourceProperty].Value),
+		ScriptSyntax:                types.StringValue(action.Properties[scriptSyntaxProperty].Value),
+		VariableSubstitutionInFiles: flattenActionString(action.Properties[substituteInFilesTargetsProperty].Value, prior.VariableSubstitutionInFiles, imported),
+	}
+}
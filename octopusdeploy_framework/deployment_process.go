@@ -0,0 +1,257 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octopusids"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deploymentProcessLocks holds a mutex for each deployment process changed by the provider, keyed by
+// deploymentProcessLockKey.
+var deploymentProcessLocks sync.Map
+
+// lockDeploymentProcess serialises changes to the deployment process of a project, so steps of the same process that
+// are created, changed or deleted at the same time do not overwrite each other. It returns the function that releases
+// the lock.
+func lockDeploymentProcess(spaceID string, projectID string, branch string) func() {
+	value, _ := deploymentProcessLocks.LoadOrStore(deploymentProcessLockKey(spaceID, projectID, branch), &sync.Mutex{})
+	lock := value.(*sync.Mutex)
+	lock.Lock()
+	return lock.Unlock
+}
+
+func deploymentProcessLockKey(spaceID string, projectID string, branch string) string {
+	return strings.Join([]string{spaceID, projectID, branch}, "/")
+}
+
+// getDeploymentProcess returns the deployment process of a project. For version-controlled projects the process is
+// read from the branch, or from the default branch when branch is empty.
+func getDeploymentProcess(client newclient.Client, spaceID string, projectID string, branch string) (*deployments.DeploymentProcess, error) {
+	project, err := projects.GetByID(client, spaceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return deployments.GetDeploymentProcessByGitRef(client, spaceID, project, branch)
+}
+
+// updateDeploymentProcess reads the deployment process of a project, changes it with modify and saves it, holding the
+// lock of the process throughout so concurrent changes to other steps are not lost.
+func updateDeploymentProcess(client newclient.Client, spaceID string, projectID string, branch string, modify func(*deployments.DeploymentProcess) error) (*deployments.DeploymentProcess, error) {
+	unlock := lockDeploymentProcess(spaceID, projectID, branch)
+	defer unlock()
+
+	process, err := getDeploymentProcess(client, spaceID, projectID, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := modify(process); err != nil {
+		return nil, err
+	}
+
+	return deployments.UpdateDeploymentProcess(client, process)
+}
+
+// deploymentStepSlug returns the slug of a step. Steps have no slug of their own in the Octopus Deploy API, so this is
+// the slug of the first action, which the step runs, and is only derived from the step name when the action has none.
+func deploymentStepSlug(step *deployments.DeploymentStep) string {
+	if len(step.Actions) > 0 && step.Actions[0].Slug != "" {
+		return step.Actions[0].Slug
+	}
+	return octopusids.Slugify(step.Name)
+}
+
+// findDeploymentStep returns the step with the ID or, when no step has the ID, the step with the slug, as the IDs of
+// steps in version-controlled projects are not guaranteed to stay the same. It returns nil when neither matches.
+func findDeploymentStep(process *deployments.DeploymentProcess, id string, slug string) *deployments.DeploymentStep {
+	for _, step := range process.Steps {
+		if id != "" && step.GetID() == id {
+			return step
+		}
+	}
+	for _, step := range process.Steps {
+		if slug != "" && deploymentStepSlug(step) == slug {
+			return step
+		}
+	}
+	return nil
+}
+
+// findDeploymentStepByName returns the step with the name, or nil when the process has no such step.
+func findDeploymentStepByName(process *deployments.DeploymentProcess, name string) *deployments.DeploymentStep {
+	for _, step := range process.Steps {
+		if step.Name == name {
+			return step
+		}
+	}
+	return nil
+}
+
+// findChildDeploymentAction returns the child action of a step with the ID or, when no child action has the ID, the
+// one with the slug. The first action of a step belongs to the step itself, so it never matches.
+func findChildDeploymentAction(step *deployments.DeploymentStep, id string, slug string) *deployments.DeploymentAction {
+	if len(step.Actions) < 2 {
+		return nil
+	}
+
+	children := step.Actions[1:]
+	for _, action := range children {
+		if id != "" && action.GetID() == id {
+			return action
+		}
+	}
+	for _, action := range children {
+		if slug != "" && action.Slug == slug {
+			return action
+		}
+	}
+	return nil
+}
+
+// findDeploymentActionByName returns the action with the name from any step of the process, or nil when there is
+// none. Action names are unique within a process.
+func findDeploymentActionByName(process *deployments.DeploymentProcess, name string) *deployments.DeploymentAction {
+	for _, step := range process.Steps {
+		for _, action := range step.Actions {
+			if action.Name == name {
+				return action
+			}
+		}
+	}
+	return nil
+}
+
+// removeDeploymentAction removes an action from a step.
+func removeDeploymentAction(step *deployments.DeploymentStep, action *deployments.DeploymentAction) {
+	for i, a := range step.Actions {
+		if a == action {
+			step.Actions = append(step.Actions[:i], step.Actions[i+1:]...)
+			return
+		}
+	}
+}
+
+// removeDeploymentStep removes a step from the process, returning whether the process contained it.
+func removeDeploymentStep(process *deployments.DeploymentProcess, step *deployments.DeploymentStep) bool {
+	for i, s := range process.Steps {
+		if s == step {
+			process.Steps = append(process.Steps[:i], process.Steps[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// findDeploymentStepByReference returns the step with the slug or, when no step has the slug, the step with the ID, as
+// the IDs of steps in version-controlled projects are not guaranteed to stay the same. It returns nil when neither
+// matches.
+func findDeploymentStepByReference(steps []*deployments.DeploymentStep, reference string) *deployments.DeploymentStep {
+	for _, step := range steps {
+		if deploymentStepSlug(step) == reference {
+			return step
+		}
+	}
+	for _, step := range steps {
+		if step.GetID() == reference {
+			return step
+		}
+	}
+	return nil
+}
+
+// orderDeploymentSteps returns the steps with the steps referenced by slug or ID in references first, in the order of
+// references, followed by the remaining steps in their current order.
+func orderDeploymentSteps(steps []*deployments.DeploymentStep, references []string) ([]*deployments.DeploymentStep, error) {
+	ordered := make([]*deployments.DeploymentStep, 0, len(steps))
+	listed := map[*deployments.DeploymentStep]bool{}
+	for _, reference := range references {
+		step := findDeploymentStepByReference(steps, reference)
+		if step == nil {
+			return nil, fmt.Errorf("the deployment process does not contain a step with the slug or ID %s", reference)
+		}
+		if listed[step] {
+			return nil, fmt.Errorf("the step %s is listed more than once", reference)
+		}
+		listed[step] = true
+		ordered = append(ordered, step)
+	}
+
+	for _, step := range steps {
+		if !listed[step] {
+			ordered = append(ordered, step)
+		}
+	}
+	return ordered, nil
+}
+
+// flattenDeploymentStepsOrder returns the references to the steps in the order they run, keeping only the steps
+// referenced by managed, as they are referenced there. Every step is returned by slug when managed is nil.
+func flattenDeploymentStepsOrder(steps []*deployments.DeploymentStep, managed []string) []string {
+	references := []string{}
+	if managed == nil {
+		for _, step := range steps {
+			references = append(references, deploymentStepSlug(step))
+		}
+		return references
+	}
+
+	referenced := map[*deployments.DeploymentStep]string{}
+	for _, reference := range managed {
+		if step := findDeploymentStepByReference(steps, reference); step != nil {
+			referenced[step] = reference
+		}
+	}
+	for _, step := range steps {
+		if reference, ok := referenced[step]; ok {
+			references = append(references, reference)
+		}
+	}
+	return references
+}
+
+// mergeManagedProperties returns the properties to save for a step, action or package: the current properties,
+// without the properties that were managed in prior and are no longer planned, and with the planned properties set.
+// A nil planned map leaves the current properties as they are.
+func mergeManagedProperties[V any](current map[string]V, prior map[string]string, planned map[string]string, value func(string) V) map[string]V {
+	merged := make(map[string]V, len(current)+len(planned))
+	for k, v := range current {
+		merged[k] = v
+	}
+	if planned == nil {
+		return merged
+	}
+
+	for k := range prior {
+		if _, ok := planned[k]; !ok {
+			delete(merged, k)
+		}
+	}
+	for k, v := range planned {
+		merged[k] = value(v)
+	}
+	return merged
+}
+
+// flattenManagedProperties returns the properties managed by Terraform: every property when managed is null or
+// unknown, such as after an import, and otherwise only the managed properties that are still set.
+func flattenManagedProperties(properties map[string]string, managed types.Map) types.Map {
+	if managed.IsNull() || managed.IsUnknown() {
+		return types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(properties))
+	}
+
+	filtered := map[string]string{}
+	for k := range managed.Elements() {
+		if v, ok := properties[k]; ok {
+			filtered[k] = v
+		}
+	}
+	return types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(filtered))
+}
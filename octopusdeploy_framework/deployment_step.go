@@ -0,0 +1,361 @@
+package octopusdeploy_framework
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// actionModel is implemented by every action block through the attributes they share.
+type actionModel interface {
+	ActionBase() schemas.DeploymentActionBaseModel
+}
+
+// expandDeploymentSteps returns the steps of a deployment or runbook process. current are the steps of the process
+// being updated; each planned step keeps the ID of the current step with the same ID or name, or of the step at the
+// same position when that step was renamed.
+func expandDeploymentSteps(ctx context.Context, planned []schemas.DeploymentStepModel, current []*deployments.DeploymentStep) []*deployments.DeploymentStep {
+	matches := make([]*deployments.DeploymentStep, len(planned))
+	claimed := map[*deployments.DeploymentStep]bool{}
+	claim := func(i int, match func(*deployments.DeploymentStep) bool) {
+		for _, step := range current {
+			if !claimed[step] && match(step) {
+				matches[i] = step
+				claimed[step] = true
+				return
+			}
+		}
+	}
+
+	for i, step := range planned {
+		if id := step.ID.ValueString(); id != "" {
+			claim(i, func(s *deployments.DeploymentStep) bool { return s.GetID() == id })
+		}
+	}
+	plannedNames := map[string]bool{}
+	for i, step := range planned {
+		name := step.Name.ValueString()
+		plannedNames[name] = true
+		if matches[i] == nil {
+			claim(i, func(s *deployments.DeploymentStep) bool { return s.Name == name })
+		}
+	}
+	for i := range planned {
+		if matches[i] == nil && i < len(current) && !claimed[current[i]] && !plannedNames[current[i].Name] {
+			matches[i] = current[i]
+			claimed[current[i]] = true
+		}
+	}
+
+	steps := make([]*deployments.DeploymentStep, 0, len(planned))
+	for i, step := range planned {
+		steps = append(steps, expandDeploymentStep(ctx, step, matches[i]))
+	}
+	return steps
+}
+
+// expandDeploymentStep returns a step of a deployment or runbook process. current is the step it replaces, or nil for
+// a new step.
+func expandDeploymentStep(ctx context.Context, plan schemas.DeploymentStepModel, current *deployments.DeploymentStep) *deployments.DeploymentStep {
+	if current == nil {
+		current = deployments.NewDeploymentStep("")
+	}
+
+	step := deployments.NewDeploymentStep(plan.Name.ValueString())
+	step.ID = current.ID
+	if plan.ID.ValueString() != "" {
+		step.ID = plan.ID.ValueString()
+	}
+	step.Condition = deployments.DeploymentStepConditionType(plan.Condition.ValueString())
+	step.PackageRequirement = deployments.DeploymentStepPackageRequirement(plan.PackageRequirement.ValueString())
+	step.StartTrigger = deployments.DeploymentStepStartTrigger(plan.StartTrigger.ValueString())
+
+	// The properties are expanded first, as the other attributes are stored in them.
+	plannedProperties := expandStringMap(plan.Properties)
+	if plan.Properties.IsUnknown() {
+		for k, v := range current.Properties {
+			step.Properties[k] = v
+		}
+	} else {
+		for k, v := range plannedProperties {
+			step.Properties[k] = newPropertyValue(v)
+		}
+	}
+
+	if plan.ConditionExpression.IsUnknown() {
+		if v, ok := current.Properties[schemas.StepConditionExpressionProperty]; ok {
+			step.Properties[schemas.StepConditionExpressionProperty] = v
+		}
+	} else {
+		setOptionalProperty(step.Properties, schemas.StepConditionExpressionProperty, plan.ConditionExpression.ValueString())
+	}
+
+	if plan.TargetRoles.IsUnknown() {
+		if v, ok := current.Properties[schemas.StepTargetRolesProperty]; ok {
+			step.Properties[schemas.StepTargetRolesProperty] = v
+		}
+	} else {
+		setOptionalProperty(step.Properties, schemas.StepTargetRolesProperty, strings.Join(util.ExpandStringList(plan.TargetRoles), ","))
+	}
+
+	if _, ok := plannedProperties[schemas.StepWindowSizeProperty]; !ok || plan.WindowSize.ValueString() != "" {
+		setOptionalProperty(step.Properties, schemas.StepWindowSizeProperty, plan.WindowSize.ValueString())
+	}
+
+	sortOrders := map[string]int64{}
+	expandActionBlocks(step, current, plan.Actions, sortOrders, expandDeploymentAction)
+	expandActionBlocks(step, current, plan.ManualInterventionActions, sortOrders, expandManualInterventionAction)
+	expandActionBlocks(step, current, plan.ApplyTerraformTemplateActions, sortOrders, expandApplyTerraformTemplateAction)
+	expandActionBlocks(step, current, plan.DeployPackageActions, sortOrders, expandDeployPackageAction)
+	expandActionBlocks(step, current, plan.DeployWindowsServiceActions, sortOrders, expandDeployWindowsServiceAction)
+	expandActionBlocks(step, current, plan.RunScriptActions, sortOrders, expandRunScriptAction)
+	expandActionBlocks(step, current, plan.RunKubectlScriptActions, sortOrders, expandRunKubectlScriptAction)
+	expandActionBlocks(step, current, plan.DeployKubernetesSecretActions, sortOrders, expandDeployKubernetesSecretAction)
+	expandActionBlocks(step, current, plan.DeployHelmChartActions, sortOrders, expandDeployHelmChartAction)
+	expandActionBlocks(step, current, plan.DeployKubernetesYamlActions, sortOrders, expandDeployKubernetesYamlAction)
+	expandActionBlocks(step, current, plan.DeployKustomizeActions, sortOrders, expandDeployKustomizeAction)
+	expandActionBlocks(step, current, plan.ApplyAwsCloudFormationChangeSetActions, sortOrders, expandApplyAwsCloudFormationChangeSetAction)
+	expandActionBlocks(step, current, plan.DeleteAwsCloudFormationStackActions, sortOrders, expandDeleteAwsCloudFormationStackAction)
+	expandActionBlocks(step, current, plan.DeployAwsCloudFormationTemplateActions, sortOrders, expandDeployAwsCloudFormationTemplateAction)
+	expandActionBlocks(step, current, plan.DeployAzureResourceGroupActions, sortOrders, expandDeployAzureResourceGroupAction)
+	sortDeploymentActions(ctx, step, current, sortOrders)
+
+	return step
+}
+
+// expandActionBlocks adds the actions of one kind of action block to a step, and records their sort_order.
+func expandActionBlocks[T actionModel](step *deployments.DeploymentStep, current *deployments.DeploymentStep, plan []T, sortOrders map[string]int64, expand func(T, *deployments.DeploymentAction) *deployments.DeploymentAction) {
+	for _, block := range plan {
+		base := block.ActionBase()
+		name := base.Name.ValueString()
+		step.Actions = append(step.Actions, expand(block, findStepActionByName(current, name)))
+
+		if !base.SortOrder.IsNull() && !base.SortOrder.IsUnknown() && base.SortOrder.ValueInt64() >= 0 {
+			sortOrders[name] = base.SortOrder.ValueInt64()
+		}
+	}
+}
+
+// sortDeploymentActions orders the actions of a step by their sort_order. Actions without one keep their position in
+// the current step, and new actions without one are added at the end in the order of their blocks.
+func sortDeploymentActions(ctx context.Context, step *deployments.DeploymentStep, current *deployments.DeploymentStep, sortOrders map[string]int64) {
+	if len(sortOrders) > 0 {
+		names := map[int64][]string{}
+		for name, order := range sortOrders {
+			names[order] = append(names[order], name)
+		}
+		for _, matching := range names {
+			if len(matching) > 1 {
+				sort.Strings(matching)
+				tflog.Warn(ctx, fmt.Sprintf("The following actions have the same sort_order: %v", matching))
+			}
+		}
+
+		if len(sortOrders) != len(step.Actions) {
+			tflog.Warn(ctx, fmt.Sprintf("Not all actions on step '%s' have a `sort_order` parameter so they may be sorted in an unexpected order", step.Name))
+		}
+	}
+
+	position := func(action *deployments.DeploymentAction) int64 {
+		if order, ok := sortOrders[action.Name]; ok {
+			return order
+		}
+		if i := slices.IndexFunc(current.Actions, func(a *deployments.DeploymentAction) bool { return a.Name == action.Name }); i >= 0 {
+			return int64(i + 1)
+		}
+		return math.MaxInt64
+	}
+	slices.SortStableFunc(step.Actions, func(a, b *deployments.DeploymentAction) int {
+		return cmp.Compare(position(a), position(b))
+	})
+}
+
+func findStepActionByName(step *deployments.DeploymentStep, name string) *deployments.DeploymentAction {
+	for _, action := range step.Actions {
+		if action.Name == name {
+			return action
+		}
+	}
+	return nil
+}
+
+// flattenDeploymentSteps returns the step blocks of a deployment or runbook process. prior are the step blocks in the
+// plan or state, which decide the block each action is read into.
+func flattenDeploymentSteps(steps []*deployments.DeploymentStep, prior []schemas.DeploymentStepModel) []schemas.DeploymentStepModel {
+	flattened := make([]schemas.DeploymentStepModel, 0, len(steps))
+	for _, step := range steps {
+		flattened = append(flattened, flattenDeploymentStep(step, findPriorDeploymentStep(prior, step)))
+	}
+	return flattened
+}
+
+func findPriorDeploymentStep(prior []schemas.DeploymentStepModel, step *deployments.DeploymentStep) *schemas.DeploymentStepModel {
+	if id := step.GetID(); id != "" {
+		for i := range prior {
+			if prior[i].ID.ValueString() == id {
+				return &prior[i]
+			}
+		}
+	}
+	for i := range prior {
+		if prior[i].Name.ValueString() == step.Name {
+			return &prior[i]
+		}
+	}
+	return nil
+}
+
+// flattenDeploymentStep returns a step block. prior is the step block in the plan or state, or nil when the step is
+// imported. An action is read into the block it is configured in, and otherwise into the block for its action type.
+func flattenDeploymentStep(step *deployments.DeploymentStep, prior *schemas.DeploymentStepModel) schemas.DeploymentStepModel {
+	if prior == nil {
+		prior = &schemas.DeploymentStepModel{}
+	}
+
+	state := schemas.DeploymentStepModel{
+		Condition:           types.StringValue(string(step.Condition)),
+		ConditionExpression: types.StringValue(step.Properties[schemas.StepConditionExpressionProperty].Value),
+		ID:                  types.StringValue(step.GetID()),
+		Name:                types.StringValue(step.Name),
+		PackageRequirement:  types.StringValue(string(step.PackageRequirement)),
+		Properties:          flattenManagedProperties(flattenPropertyValues(step.Properties), prior.Properties),
+		StartTrigger:        types.StringValue(string(step.StartTrigger)),
+		TargetRoles:         util.FlattenStringList(splitTargetRoles(step.Properties[schemas.StepTargetRolesProperty].Value)),
+		WindowSize:          flattenOptionalString(step.Properties[schemas.StepWindowSizeProperty].Value, prior.WindowSize),
+
+		Actions:                                []schemas.DeploymentActionModel{},
+		ApplyAwsCloudFormationChangeSetActions: []schemas.ApplyAwsCloudFormationChangeSetActionModel{},
+		ApplyTerraformTemplateActions:          []schemas.ApplyTerraformTemplateActionModel{},
+		DeleteAwsCloudFormationStackActions:    []schemas.DeleteAwsCloudFormationStackActionModel{},
+		DeployAwsCloudFormationTemplateActions: []schemas.DeployAwsCloudFormationTemplateActionModel{},
+		DeployAzureResourceGroupActions:        []schemas.DeployAzureResourceGroupActionModel{},
+		DeployHelmChartActions:                 []schemas.DeployHelmChartActionModel{},
+		DeployKubernetesSecretActions:          []schemas.DeployKubernetesSecretActionModel{},
+		DeployKubernetesYamlActions:            []schemas.DeployKubernetesYamlActionModel{},
+		DeployKustomizeActions:                 []schemas.DeployKustomizeActionModel{},
+		DeployPackageActions:                   []schemas.DeployPackageActionModel{},
+		DeployWindowsServiceActions:            []schemas.DeployWindowsServiceActionModel{},
+		ManualInterventionActions:              []schemas.ManualInterventionActionModel{},
+		RunKubectlScriptActions:                []schemas.RunKubectlScriptActionModel{},
+		RunScriptActions:                       []schemas.RunScriptActionModel{},
+	}
+
+	for i, action := range step.Actions {
+		switch priorActionBlockName(prior, action) {
+		case "apply_aws_cloudformation_change_set_action":
+			state.ApplyAwsCloudFormationChangeSetActions = append(state.ApplyAwsCloudFormationChangeSetActions, flattenApplyAwsCloudFormationChangeSetAction(action, findPriorAction(prior.ApplyAwsCloudFormationChangeSetActions, action.Name), i))
+		case "apply_terraform_template_action":
+			state.ApplyTerraformTemplateActions = append(state.ApplyTerraformTemplateActions, flattenApplyTerraformTemplateAction(action, findPriorAction(prior.ApplyTerraformTemplateActions, action.Name), i))
+		case "delete_aws_cloudformation_stack_action":
+			state.DeleteAwsCloudFormationStackActions = append(state.DeleteAwsCloudFormationStackActions, flattenDeleteAwsCloudFormationStackAction(action, findPriorAction(prior.DeleteAwsCloudFormationStackActions, action.Name), i))
+		case "deploy_aws_cloudformation_template_action":
+			state.DeployAwsCloudFormationTemplateActions = append(state.DeployAwsCloudFormationTemplateActions, flattenDeployAwsCloudFormationTemplateAction(action, findPriorAction(prior.DeployAwsCloudFormationTemplateActions, action.Name), i))
+		case "deploy_azure_resource_group_action":
+			state.DeployAzureResourceGroupActions = append(state.DeployAzureResourceGroupActions, flattenDeployAzureResourceGroupAction(action, findPriorAction(prior.DeployAzureResourceGroupActions, action.Name), i))
+		case "deploy_helm_chart_action":
+			state.DeployHelmChartActions = append(state.DeployHelmChartActions, flattenDeployHelmChartAction(action, findPriorAction(prior.DeployHelmChartActions, action.Name), i))
+		case "deploy_kubernetes_secret_action":
+			state.DeployKubernetesSecretActions = append(state.DeployKubernetesSecretActions, flattenDeployKubernetesSecretAction(action, findPriorAction(prior.DeployKubernetesSecretActions, action.Name), i))
+		case "deploy_kubernetes_yaml_action":
+			state.DeployKubernetesYamlActions = append(state.DeployKubernetesYamlActions, flattenDeployKubernetesYamlAction(action, findPriorAction(prior.DeployKubernetesYamlActions, action.Name), i))
+		case "deploy_kustomize_action":
+			state.DeployKustomizeActions = append(state.DeployKustomizeActions, flattenDeployKustomizeAction(action, findPriorAction(prior.DeployKustomizeActions, action.Name), i))
+		case "deploy_package_action":
+			state.DeployPackageActions = append(state.DeployPackageActions, flattenDeployPackageAction(action, findPriorAction(prior.DeployPackageActions, action.Name), i))
+		case "deploy_windows_service_action":
+			state.DeployWindowsServiceActions = append(state.DeployWindowsServiceActions, flattenDeployWindowsServiceAction(action, findPriorAction(prior.DeployWindowsServiceActions, action.Name), i))
+		case "manual_intervention_action":
+			state.ManualInterventionActions = append(state.ManualInterventionActions, flattenManualInterventionAction(action, findPriorAction(prior.ManualInterventionActions, action.Name), i))
+		case "run_kubectl_script_action":
+			state.RunKubectlScriptActions = append(state.RunKubectlScriptActions, flattenRunKubectlScriptAction(action, findPriorAction(prior.RunKubectlScriptActions, action.Name), i))
+		case "run_script_action":
+			state.RunScriptActions = append(state.RunScriptActions, flattenRunScriptAction(action, findPriorAction(prior.RunScriptActions, action.Name), i))
+		default:
+			state.Actions = append(state.Actions, flattenDeploymentAction(action, findPriorAction(prior.Actions, action.Name), i))
+		}
+	}
+
+	sortActionBlocks(state.Actions, prior.Actions)
+	sortActionBlocks(state.ApplyAwsCloudFormationChangeSetActions, prior.ApplyAwsCloudFormationChangeSetActions)
+	sortActionBlocks(state.ApplyTerraformTemplateActions, prior.ApplyTerraformTemplateActions)
+	sortActionBlocks(state.DeleteAwsCloudFormationStackActions, prior.DeleteAwsCloudFormationStackActions)
+	sortActionBlocks(state.DeployAwsCloudFormationTemplateActions, prior.DeployAwsCloudFormationTemplateActions)
+	sortActionBlocks(state.DeployAzureResourceGroupActions, prior.DeployAzureResourceGroupActions)
+	sortActionBlocks(state.DeployHelmChartActions, prior.DeployHelmChartActions)
+	sortActionBlocks(state.DeployKubernetesSecretActions, prior.DeployKubernetesSecretActions)
+	sortActionBlocks(state.DeployKubernetesYamlActions, prior.DeployKubernetesYamlActions)
+	sortActionBlocks(state.DeployKustomizeActions, prior.DeployKustomizeActions)
+	sortActionBlocks(state.DeployPackageActions, prior.DeployPackageActions)
+	sortActionBlocks(state.DeployWindowsServiceActions, prior.DeployWindowsServiceActions)
+	sortActionBlocks(state.ManualInterventionActions, prior.ManualInterventionActions)
+	sortActionBlocks(state.RunKubectlScriptActions, prior.RunKubectlScriptActions)
+	sortActionBlocks(state.RunScriptActions, prior.RunScriptActions)
+
+	return state
+}
+
+// priorActionBlockName returns the name of the block an action is configured in, or of the block for its action type
+// when it is not configured.
+func priorActionBlockName(prior *schemas.DeploymentStepModel, action *deployments.DeploymentAction) string {
+	switch {
+	case findPriorAction(prior.Actions, action.Name) != nil:
+		return "action"
+	case findPriorAction(prior.ApplyAwsCloudFormationChangeSetActions, action.Name) != nil:
+		return "apply_aws_cloudformation_change_set_action"
+	case findPriorAction(prior.ApplyTerraformTemplateActions, action.Name) != nil:
+		return "apply_terraform_template_action"
+	case findPriorAction(prior.DeleteAwsCloudFormationStackActions, action.Name) != nil:
+		return "delete_aws_cloudformation_stack_action"
+	case findPriorAction(prior.DeployAwsCloudFormationTemplateActions, action.Name) != nil:
+		return "deploy_aws_cloudformation_template_action"
+	case findPriorAction(prior.DeployAzureResourceGroupActions, action.Name) != nil:
+		return "deploy_azure_resource_group_action"
+	case findPriorAction(prior.DeployHelmChartActions, action.Name) != nil:
+		return "deploy_helm_chart_action"
+	case findPriorAction(prior.DeployKubernetesSecretActions, action.Name) != nil:
+		return "deploy_kubernetes_secret_action"
+	case findPriorAction(prior.DeployKubernetesYamlActions, action.Name) != nil:
+		return "deploy_kubernetes_yaml_action"
+	case findPriorAction(prior.DeployKustomizeActions, action.Name) != nil:
+		return "deploy_kustomize_action"
+	case findPriorAction(prior.DeployPackageActions, action.Name) != nil:
+		return "deploy_package_action"
+	case findPriorAction(prior.DeployWindowsServiceActions, action.Name) != nil:
+		return "deploy_windows_service_action"
+	case findPriorAction(prior.ManualInterventionActions, action.Name) != nil:
+		return "manual_intervention_action"
+	case findPriorAction(prior.RunKubectlScriptActions, action.Name) != nil:
+		return "run_kubectl_script_action"
+	case findPriorAction(prior.RunScriptActions, action.Name) != nil:
+		return "run_script_action"
+	}
+	return schemas.GetActionBlockName(action.ActionType)
+}
+
+func findPriorAction[T actionModel](prior []T, name string) *T {
+	for i := range prior {
+		if prior[i].ActionBase().Name.ValueString() == name {
+			return &prior[i]
+		}
+	}
+	return nil
+}
+
+// sortActionBlocks keeps the blocks of one kind of action in the order they are configured.
+func sortActionBlocks[T actionModel](values []T, prior []T) {
+	names := make([]string, 0, len(prior))
+	for _, block := range prior {
+		names = append(names, block.ActionBase().Name.ValueString())
+	}
+	sortByPriorNames(values, func(block T) string { return block.ActionBase().Name.ValueString() }, names)
+}
@@ -0,0 +1,142 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiKeyPrivateKey is the key of the private data recording which API key to revoke when the ephemeral resource is
+// closed.
+const apiKeyPrivateKey = "api_key"
+
+type apiKeyEphemeralResource struct {
+	*Config
+}
+
+type apiKeyPrivateData struct {
+	UserID string `json:"user_id"`
+	ID     string `json:"id"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &apiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &apiKeyEphemeralResource{}
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+func (r *apiKeyEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = util.GetTypeName("api_key")
+}
+
+func (r *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schemas.ApiKeySchema{}.GetEphemeralResourceSchema()
+}
+
+func (r *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.Config = EphemeralResourceConfiguration(req, resp)
+}
+
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data schemas.ApiKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.Config == nil {
+		resp.Diagnostics.AddError("unable to create API key", "the provider is not configured, which happens when its configuration is not known yet")
+		return
+	}
+
+	if r.Config.ReadOnly {
+		resp.Diagnostics.AddError(providerconfig.ReadOnlySummary, providerconfig.ReadOnlyDetail("create", util.GetTypeName("api_key")))
+		return
+	}
+
+	purpose := data.Purpose.ValueString()
+	if purpose == "" {
+		purpose = schemas.ApiKeyDefaultPurpose
+	}
+
+	apiKey := users.NewAPIKey(purpose, data.UserID.ValueString())
+	if expiresIn := data.ExpiresIn.ValueString(); expiresIn != "" {
+		duration, err := time.ParseDuration(expiresIn)
+		if err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "invalid expires_in", fmt.Sprintf("%q is not a positive duration such as 30m or 2h", expiresIn))
+			return
+		}
+
+		expires := time.Now().UTC().Add(duration)
+		apiKey.Expires = &expires
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating API key for %s", apiKey.UserID))
+
+	created, err := r.Config.Client.APIKeys.Create(apiKey)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create API key", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("API key created (%s)", created.GetID()))
+
+	data.ID = types.StringValue(created.GetID())
+	data.Purpose = types.StringValue(purpose)
+	data.ApiKey = types.StringValue(created.APIKey)
+	data.Expires = types.StringValue("")
+	if created.Expires != nil {
+		data.Expires = types.StringValue(created.Expires.UTC().Format(time.RFC3339))
+	} else if apiKey.Expires != nil {
+		data.Expires = types.StringValue(apiKey.Expires.Format(time.RFC3339))
+	}
+
+	private, err := json.Marshal(apiKeyPrivateData{UserID: apiKey.UserID, ID: created.GetID()})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to record API key", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, private)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var key apiKeyPrivateData
+	if err := json.Unmarshal(private, &key); err != nil {
+		resp.Diagnostics.AddError("unable to read API key", err.Error())
+		return
+	}
+
+	if r.Config == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to revoke API key %s", key.ID), "the provider is not configured")
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("revoking API key (%s)", key.ID))
+
+	if err := services.ApiDelete(r.Config.Client.Sling(), fmt.Sprintf("/api/users/%s/apikeys/%s", key.UserID, key.ID)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to revoke API key %s", key.ID), err.Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("API key revoked (%s)", key.ID))
+}
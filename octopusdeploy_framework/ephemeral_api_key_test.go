@@ -0,0 +1,58 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func openApiKey(t *testing.T, config *Config, expiresIn string) ephemeral.OpenResponse {
+	schemaResponse := ephemeral.SchemaResponse{}
+	NewApiKeyEphemeralResource().Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	ephemeralSchema := schemaResponse.Schema
+	objectType := ephemeralSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["user_id"] = tftypes.NewValue(tftypes.String, "Users-1")
+	values["expires_in"] = tftypes.NewValue(tftypes.String, expiresIn)
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: ephemeralSchema, Raw: tftypes.NewValue(objectType, values)}}
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: ephemeralSchema, Raw: tftypes.NewValue(objectType, nil)}}
+
+	resource := &apiKeyEphemeralResource{Config: config}
+	resource.Open(context.Background(), req, &resp)
+	return resp
+}
+
+func TestApiKeyRefusedWhenReadOnly(t *testing.T) {
+	resp := openApiKey(t, &Config{Config: &providerconfig.Config{Settings: providerconfig.Settings{ReadOnly: true}}}, "1h")
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, providerconfig.ReadOnlySummary, resp.Diagnostics[0].Summary())
+}
+
+func TestApiKeyRejectsInvalidExpiry(t *testing.T) {
+	for _, expiresIn := range []string{"tomorrow", "-1h", "0s"} {
+		resp := openApiKey(t, &Config{Config: &providerconfig.Config{}}, expiresIn)
+
+		require.True(t, resp.Diagnostics.HasError(), expiresIn)
+		require.Equal(t, "invalid expires_in", resp.Diagnostics[0].Summary(), expiresIn)
+	}
+}
+
+func TestApiKeyRequiresConfiguredProvider(t *testing.T) {
+	resp := openApiKey(t, nil, "1h")
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "unable to create API key", resp.Diagnostics[0].Summary())
+}
@@ -0,0 +1,52 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tentacleCertificateEphemeralResource struct{}
+
+func NewTentacleCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &tentacleCertificateEphemeralResource{}
+}
+
+func (r *tentacleCertificateEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = util.GetTypeName("tentacle_certificate")
+}
+
+func (r *tentacleCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schemas.GetTentacleCertificateEphemeralResourceSchema()
+}
+
+func (r *tentacleCertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data schemas.TentacleCertificateEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validity, err := parseCertificateValidity(data.ValidFor)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("valid_for"), "invalid valid_for", err.Error())
+		return
+	}
+
+	certificate, err := generateCertificate("Octopus Tentacle", validity)
+	if err != nil {
+		resp.Diagnostics.AddError("cannot generate tentacle", err.Error())
+		return
+	}
+
+	data.Base64 = types.StringValue(certificate.Base64)
+	data.Thumbprint = types.StringValue(certificate.Thumbprint)
+	data.Expires = types.StringValue(certificate.Expires.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
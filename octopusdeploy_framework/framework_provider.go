@@ -2,13 +2,26 @@ package octopusdeploy_framework
 
 import (
 	"context"
+	"fmt"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidc"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/transport"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"os"
+	"strconv"
+	"time"
 )
 
 type octopusDeployFrameworkProvider struct {
@@ -16,11 +29,46 @@ type octopusDeployFrameworkProvider struct {
 	ApiKey      types.String `tfsdk:"api_key"`
 	AccessToken types.String `tfsdk:"access_token"`
 	SpaceID     types.String `tfsdk:"space_id"`
+	SpaceName   types.String `tfsdk:"space_name"`
+	SpaceSlug   types.String `tfsdk:"space_slug"`
+
+	MaxRetries   types.Int64 `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64 `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64 `tfsdk:"retry_wait_max"`
+
+	CACertificateFile  types.String `tfsdk:"ca_certificate_file"`
+	CACertificatePEM   types.String `tfsdk:"ca_certificate_pem"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	ReadOnly           types.Bool   `tfsdk:"read_only"`
+	CacheReads         types.Bool   `tfsdk:"cache_reads"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64 `tfsdk:"requests_per_second"`
+
+	RequiredServerVersion  types.String `tfsdk:"required_server_version"`
+	RequiredFeatureToggles types.List   `tfsdk:"required_feature_toggles"`
+
+	OIDC []oidcProviderModel `tfsdk:"oidc"`
+}
+
+type oidcProviderModel struct {
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+	Audience            types.String `tfsdk:"audience"`
+	IdentityToken       types.String `tfsdk:"identity_token"`
+	IdentityTokenFile   types.String `tfsdk:"identity_token_file"`
+	IdentityTokenEnvVar types.String `tfsdk:"identity_token_env_var"`
+	TokenRequestURL     types.String `tfsdk:"token_request_url"`
+	TokenRequestToken   types.String `tfsdk:"token_request_token"`
 }
 
 var _ provider.Provider = (*octopusDeployFrameworkProvider)(nil)
 var _ provider.ProviderWithMetaSchema = (*octopusDeployFrameworkProvider)(nil)
-var _ provider.ProviderWithFunctions
+var _ provider.ProviderWithFunctions = (*octopusDeployFrameworkProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*octopusDeployFrameworkProvider)(nil)
 
 func NewOctopusDeployFrameworkProvider() *octopusDeployFrameworkProvider {
 	return &octopusDeployFrameworkProvider{}
@@ -40,30 +88,127 @@ func (p *octopusDeployFrameworkProvider) Configure(ctx context.Context, req prov
 		return
 	}
 
-	config := Config{}
-	config.ApiKey = providerData.ApiKey.ValueString()
-	if config.ApiKey == "" {
-		config.ApiKey = os.Getenv("OCTOPUS_APIKEY")
+	settings := providerconfig.Settings{}
+	settings.APIKey = providerData.ApiKey.ValueString()
+	if settings.APIKey == "" {
+		settings.APIKey = os.Getenv("OCTOPUS_APIKEY")
+	}
+	if settings.APIKey == "" {
+		settings.APIKey = os.Getenv("OCTOPUS_API_KEY")
+	}
+	settings.AccessToken = providerData.AccessToken.ValueString()
+	if settings.AccessToken == "" {
+		settings.AccessToken = os.Getenv("OCTOPUS_ACCESS_TOKEN")
+	}
+	settings.Address = providerData.Address.ValueString()
+	if settings.Address == "" {
+		settings.Address = os.Getenv("OCTOPUS_URL")
+	}
+	settings.SpaceID = providerData.SpaceID.ValueString()
+	settings.SpaceName = providerData.SpaceName.ValueString()
+	settings.SpaceSlug = providerData.SpaceSlug.ValueString()
+
+	settings.MaxRetries = transport.DefaultMaxRetries
+	if !providerData.MaxRetries.IsNull() {
+		settings.MaxRetries = int(providerData.MaxRetries.ValueInt64())
+	}
+	settings.RetryWaitMin = transport.DefaultRetryWaitMin
+	if !providerData.RetryWaitMin.IsNull() {
+		settings.RetryWaitMin = time.Duration(providerData.RetryWaitMin.ValueInt64()) * time.Second
+	}
+	settings.RetryWaitMax = transport.DefaultRetryWaitMax
+	if !providerData.RetryWaitMax.IsNull() {
+		settings.RetryWaitMax = time.Duration(providerData.RetryWaitMax.ValueInt64()) * time.Second
+	}
+
+	settings.CACertificateFile = stringValueOrEnv(providerData.CACertificateFile, "OCTOPUS_CA_CERTIFICATE_FILE")
+	settings.CACertificatePEM = stringValueOrEnv(providerData.CACertificatePEM, "OCTOPUS_CA_CERTIFICATE_PEM")
+	settings.ClientCertificate = stringValueOrEnv(providerData.ClientCertificate, "OCTOPUS_CLIENT_CERTIFICATE")
+	settings.ClientKey = stringValueOrEnv(providerData.ClientKey, "OCTOPUS_CLIENT_KEY")
+	settings.ProxyURL = stringValueOrEnv(providerData.ProxyURL, "OCTOPUS_PROXY_URL")
+
+	insecureSkipVerify, err := boolValueOrEnv(providerData.InsecureSkipVerify, "OCTOPUS_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid insecure_skip_verify", err.Error())
+		return
+	}
+	settings.InsecureSkipVerify = insecureSkipVerify
+
+	readOnly, err := boolValueOrEnv(providerData.ReadOnly, "OCTOPUS_READ_ONLY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("read_only"), "Invalid read_only", err.Error())
+		return
+	}
+	settings.ReadOnly = readOnly
+
+	cacheReads, err := boolValueOrEnv(providerData.CacheReads, "OCTOPUS_CACHE_READS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cache_reads"), "Invalid cache_reads", err.Error())
+		return
+	}
+	settings.CacheReads = cacheReads
+
+	requestTimeout, err := int64ValueOrEnv(providerData.RequestTimeout, "OCTOPUS_REQUEST_TIMEOUT")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", err.Error())
+		return
+	}
+	settings.RequestTimeout = time.Duration(requestTimeout) * time.Second
+
+	maxConcurrentRequests, err := int64ValueOrEnv(providerData.MaxConcurrentRequests, "OCTOPUS_MAX_CONCURRENT_REQUESTS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid max_concurrent_requests", err.Error())
+		return
 	}
-	if config.ApiKey == "" {
-		config.ApiKey = os.Getenv("OCTOPUS_API_KEY")
+	settings.MaxConcurrentRequests = int(maxConcurrentRequests)
+
+	requestsPerSecond, err := int64ValueOrEnv(providerData.RequestsPerSecond, "OCTOPUS_REQUESTS_PER_SECOND")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "Invalid requests_per_second", err.Error())
+		return
 	}
-	config.AccessToken = providerData.AccessToken.ValueString()
-	if config.AccessToken == "" {
-		config.AccessToken = os.Getenv("OCTOPUS_ACCESS_TOKEN")
+	settings.RequestsPerSecond = int(requestsPerSecond)
+
+	settings.RequiredServerVersion = providerData.RequiredServerVersion.ValueString()
+	if !providerData.RequiredFeatureToggles.IsNull() && !providerData.RequiredFeatureToggles.IsUnknown() {
+		resp.Diagnostics.Append(providerData.RequiredFeatureToggles.ElementsAs(ctx, &settings.RequiredFeatureToggles, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	config.Address = providerData.Address.ValueString()
-	if config.Address == "" {
-		config.Address = os.Getenv("OCTOPUS_URL")
+
+	if len(providerData.OIDC) > 0 {
+		oidcData := providerData.OIDC[0]
+		settings.OIDC = &oidc.Options{
+			ServiceAccountID:    oidcData.ServiceAccountID.ValueString(),
+			Audience:            oidcData.Audience.ValueString(),
+			IdentityToken:       oidcData.IdentityToken.ValueString(),
+			IdentityTokenFile:   oidcData.IdentityTokenFile.ValueString(),
+			IdentityTokenEnvVar: oidcData.IdentityTokenEnvVar.ValueString(),
+			TokenRequestURL:     oidcData.TokenRequestURL.ValueString(),
+			TokenRequestToken:   oidcData.TokenRequestToken.ValueString(),
+		}
 	}
-	config.SpaceID = providerData.SpaceID.ValueString()
 
+	config := &Config{Config: providerconfig.Shared(settings)}
 	if diags := config.SetOctopus(ctx); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
 
-	resp.DataSourceData = &config
-	resp.ResourceData = &config
+	resp.DataSourceData = config
+	resp.ResourceData = config
+	resp.EphemeralResourceData = config
+}
+
+func (p *octopusDeployFrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSlugifyFunction,
+		NewParseIDFunction,
+		NewSpaceQualifiedIDFunction,
+		NewTenantTagFunction,
+		NewEvaluateExpressionFunction,
+		NewVersionMatchesChannelRuleFunction,
+	}
 }
 
 func (p *octopusDeployFrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -88,11 +233,19 @@ func (p *octopusDeployFrameworkProvider) DataSources(ctx context.Context) []func
 		NewServiceAccountOIDCIdentityDataSource,
 		NewWorkersDataSource,
 		NewDeploymentFreezeDataSource,
+		NewEvaluatedExpressionDataSource,
+	}
+}
+
+func (p *octopusDeployFrameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
+		NewTentacleCertificateEphemeralResource,
 	}
 }
 
 func (p *octopusDeployFrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return wrapResources([]func() resource.Resource{
 		NewCertificateResource,
 		NewSpaceResource,
 		NewProjectGroupResource,
@@ -123,6 +276,15 @@ func (p *octopusDeployFrameworkProvider) Resources(ctx context.Context) []func()
 		NewTagSetResource,
 		NewUsernamePasswordAccountResource,
 		NewRunbookResource,
+		NewRunbookRunResource,
+		NewRunbookSnapshotResource,
+		NewReleaseResource,
+		NewDeploymentResource,
+		NewProcessStepResource,
+		NewProcessChildStepResource,
+		NewProcessStepsOrderResource,
+		NewDeploymentProcessResource,
+		NewRunbookProcessResource,
 		NewTenantResource,
 		NewTentacleCertificateResource,
 		NewListeningTentacleWorkerResource,
@@ -136,7 +298,7 @@ func (p *octopusDeployFrameworkProvider) Resources(ctx context.Context) []func()
 		NewDeploymentFreezeTenantResource,
 		NewGitTriggerResource,
 		NewBuiltInTriggerResource,
-	}
+	})
 }
 
 func (p *octopusDeployFrameworkProvider) Schema(_ context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -158,6 +320,172 @@ func (p *octopusDeployFrameworkProvider) Schema(_ context.Context, req provider.
 				Optional:    true,
 				Description: "The space ID to target",
 			},
+			"space_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the space to target, resolved to its space ID when the provider is configured. Conflicts with `space_id` and `space_slug`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("space_id"), path.MatchRoot("space_slug")),
+				},
+			},
+			"space_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the space to target, resolved to its space ID when the provider is configured. Conflicts with `space_id` and `space_name`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("space_id"), path.MatchRoot("space_name")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of times a request to the Octopus REST API is retried after a transient failure. Set to 0 to disable retries",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests sent to the Octopus REST API at the same time. Further requests wait for one to complete. Defaults to no limit",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests sent to the Octopus REST API each second. Further requests wait their turn. Defaults to no limit",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The minimum time, in seconds, to wait before retrying a failed request to the Octopus REST API",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum time, in seconds, to wait before retrying a failed request to the Octopus REST API",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"cache_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, identical GET requests to the Octopus REST API are sent once per plan or apply and answered from a cache afterwards. Cached responses are discarded when the provider writes to the same resource",
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded CA certificate bundle used to verify the Octopus Server certificate",
+			},
+			"ca_certificate_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA certificate bundle used to verify the Octopus Server certificate",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate, or the path to one, presented to the Octopus Server for mutual TLS",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded private key, or the path to one, for the client certificate presented to the Octopus Server for mutual TLS",
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the Octopus Server TLS certificate. This should only be used for testing",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of the HTTP proxy used to reach the Octopus REST API. Defaults to the proxy configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, the provider refuses to create, update or delete resources, and only sends read requests to the Octopus REST API. Reads, imports and data sources are unaffected",
+			},
+			"required_feature_toggles": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Feature toggles that must be enabled on the Octopus Server. Configuring the provider fails if any is not enabled",
+			},
+			"required_server_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "A constraint the version of the Octopus Server must satisfy, such as `>= 2024.3`. Several constraints can be separated by commas. Configuring the provider fails if the server does not satisfy it",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The time, in seconds, after which a request to the Octopus REST API, including any retries, is abandoned. Defaults to no timeout",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oidc": schema.ListNestedBlock{
+				Description: "Exchange an identity token issued by a CI system, such as GitHub Actions or GitLab, for a short-lived access token of an Octopus service account",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service_account_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the service account whose OIDC identity trusts the identity token",
+						},
+						"audience": schema.StringAttribute{
+							Optional:    true,
+							Description: "The audience requested from the token request URL. Defaults to the service account ID",
+						},
+						"identity_token": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The identity token to exchange",
+						},
+						"identity_token_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "The path to a file containing the identity token to exchange",
+						},
+						"identity_token_env_var": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the environment variable containing the identity token to exchange, such as a GitLab `id_tokens` variable",
+						},
+						"token_request_url": schema.StringAttribute{
+							Optional:    true,
+							Description: "The URL from which a new identity token is requested. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_URL` environment variable set by GitHub Actions when no other identity token source is configured",
+						},
+						"token_request_token": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The bearer token used to call the token request URL. Defaults to the `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variable set by GitHub Actions",
+						},
+					},
+				},
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+			},
 		},
 	}
 }
+
+func stringValueOrEnv(value types.String, key string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(key)
+}
+
+func boolValueOrEnv(value types.Bool, key string) (bool, error) {
+	if !value.IsNull() {
+		return value.ValueBool(), nil
+	}
+
+	if env := os.Getenv(key); env != "" {
+		parsed, err := strconv.ParseBool(env)
+		if err != nil {
+			return false, fmt.Errorf("environment variable %s must be a boolean: %w", key, err)
+		}
+		return parsed, nil
+	}
+
+	return false, nil
+}
+
+func int64ValueOrEnv(value types.Int64, key string) (int64, error) {
+	if !value.IsNull() {
+		return value.ValueInt64(), nil
+	}
+
+	if env := os.Getenv(key); env != "" {
+		parsed, err := strconv.ParseInt(env, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("environment variable %s must be a number: %w", key, err)
+		}
+		return parsed, nil
+	}
+
+	return 0, nil
+}
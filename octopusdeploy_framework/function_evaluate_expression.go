@@ -0,0 +1,57 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octostache"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &evaluateExpressionFunction{}
+
+type evaluateExpressionFunction struct{}
+
+func NewEvaluateExpressionFunction() function.Function {
+	return &evaluateExpressionFunction{}
+}
+
+func (f *evaluateExpressionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_expression"
+}
+
+func (f *evaluateExpressionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Evaluates an Octostache template against a map of variables",
+		Description: "Evaluates an Octostache template, such as a variable value or a step property, against a map of variables, supporting substitution, filters, `#{if}`, `#{unless}` and `#{each}`. As in Octopus Deploy, an expression that cannot be resolved is left in the result as written. The function returns an error if the template cannot be parsed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "The Octostache template to evaluate.",
+			},
+			function.MapParameter{
+				Name:        "variables",
+				Description: "The variables available to the template, keyed by name.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *evaluateExpressionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var variables map[string]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &variables))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := octostache.Evaluate(template, variables)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
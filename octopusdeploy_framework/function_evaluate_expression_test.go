@@ -0,0 +1,39 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func runEvaluateExpression(template string, variables map[string]string) function.RunResponse {
+	values := map[string]attr.Value{}
+	for name, value := range variables {
+		values[name] = types.StringValue(value)
+	}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.StringValue(template),
+		types.MapValueMust(types.StringType, values),
+	})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewEvaluateExpressionFunction().Run(context.Background(), req, &resp)
+	return resp
+}
+
+func TestEvaluateExpressionFunction(t *testing.T) {
+	resp := runEvaluateExpression("#{each r in Regions}#{r | ToUpper}#{unless Octopus.Template.Each.Last},#{/unless}#{/each}", map[string]string{"Regions": "us-east,eu-west"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, types.StringValue("US-EAST,EU-WEST"), resp.Result.Value())
+}
+
+func TestEvaluateExpressionFunctionRejectsInvalidTemplate(t *testing.T) {
+	resp := runEvaluateExpression("#{if Enabled}on", nil)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
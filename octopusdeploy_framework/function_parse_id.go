@@ -0,0 +1,67 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octopusids"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseIDFunction{}
+
+var parsedIDAttributeTypes = map[string]attr.Type{
+	"type":   types.StringType,
+	"number": types.Int64Type,
+}
+
+type parseIDFunction struct{}
+
+type parsedIDModel struct {
+	Type   types.String `tfsdk:"type"`
+	Number types.Int64  `tfsdk:"number"`
+}
+
+func NewParseIDFunction() function.Function {
+	return &parseIDFunction{}
+}
+
+func (f *parseIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+func (f *parseIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Splits an Octopus Deploy ID into its type and number",
+		Description: "Returns an object with the type and number of an Octopus Deploy ID, such as `{ type = \"Projects\", number = 123 }` for `Projects-123`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The Octopus Deploy ID to parse, such as Projects-123.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedIDAttributeTypes,
+		},
+	}
+}
+
+func (f *parseIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := octopusids.ParseID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsedIDModel{
+		Type:   types.StringValue(parsed.Type),
+		Number: types.Int64Value(parsed.Number),
+	}))
+}
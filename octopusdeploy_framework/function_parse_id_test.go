@@ -0,0 +1,34 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIDFunction(t *testing.T) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("Projects-123")})}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(parsedIDAttributeTypes))}
+
+	NewParseIDFunction().Run(context.Background(), req, &resp)
+	require.Nil(t, resp.Error)
+
+	expected := types.ObjectValueMust(parsedIDAttributeTypes, map[string]attr.Value{
+		"type":   types.StringValue("Projects"),
+		"number": types.Int64Value(123),
+	})
+	require.True(t, expected.Equal(resp.Result.Value()))
+}
+
+func TestParseIDFunctionRejectsInvalidID(t *testing.T) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("Projects")})}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(parsedIDAttributeTypes))}
+
+	NewParseIDFunction().Run(context.Background(), req, &resp)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
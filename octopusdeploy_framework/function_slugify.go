@@ -0,0 +1,44 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octopusids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &slugifyFunction{}
+
+type slugifyFunction struct{}
+
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+func (f *slugifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the slug Octopus Deploy generates for a name",
+		Description: "Lowercases the name, removes accents and apostrophes, and replaces each run of characters other than letters and digits with a single hyphen, as the Octopus Server does when it generates a slug.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to generate a slug for.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, octopusids.Slugify(name)))
+}
@@ -0,0 +1,54 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octopusids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &spaceQualifiedIDFunction{}
+
+type spaceQualifiedIDFunction struct{}
+
+func NewSpaceQualifiedIDFunction() function.Function {
+	return &spaceQualifiedIDFunction{}
+}
+
+func (f *spaceQualifiedIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "space_qualified_id"
+}
+
+func (f *spaceQualifiedIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Qualifies an Octopus Deploy ID with the ID of its space",
+		Description: "Returns the ID prefixed with the ID of its space, such as `Spaces-1/Projects-123`, which identifies a resource unambiguously when a configuration works with several spaces.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "space_id",
+				Description: "The ID of the space, such as Spaces-1.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the resource, such as Projects-123.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *spaceQualifiedIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var spaceID, id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &spaceID, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	qualified, err := octopusids.SpaceQualifiedID(spaceID, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, qualified))
+}
@@ -0,0 +1,54 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octopusids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &tenantTagFunction{}
+
+type tenantTagFunction struct{}
+
+func NewTenantTagFunction() function.Function {
+	return &tenantTagFunction{}
+}
+
+func (f *tenantTagFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tenant_tag"
+}
+
+func (f *tenantTagFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the canonical name of a tenant tag",
+		Description: "Returns the canonical name of a tag, such as `Regions/Europe`, which is how tenant tags are referenced by the `tenant_tags` attributes of resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "tag_set",
+				Description: "The name of the tag set.",
+			},
+			function.StringParameter{
+				Name:        "tag",
+				Description: "The name of the tag.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *tenantTagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tagSet, tag string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tagSet, &tag))
+	if resp.Error != nil {
+		return
+	}
+
+	canonicalName, err := octopusids.TenantTag(tagSet, tag)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, canonicalName))
+}
@@ -0,0 +1,71 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/channelrules"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &versionMatchesChannelRuleFunction{}
+
+type versionMatchesChannelRuleFunction struct{}
+
+func NewVersionMatchesChannelRuleFunction() function.Function {
+	return &versionMatchesChannelRuleFunction{}
+}
+
+func (f *versionMatchesChannelRuleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "version_matches_channel_rule"
+}
+
+func (f *versionMatchesChannelRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a package version satisfies a channel rule",
+		Description: "Returns whether a package version satisfies the `version_range` and `tag` of a channel rule, as the Octopus Server checks them when a release is created. An empty range or tag matches every version. The tag is matched against the pre-release tag of the version, so `^$` only matches releases. The function returns an error if the version, range or tag cannot be parsed, or if the tag uses .NET regular expression syntax, such as lookaround or backreferences, which the provider cannot evaluate.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "version",
+				Description: "The package version, such as `1.2.3-beta.1`.",
+			},
+			function.StringParameter{
+				Name:        "range",
+				Description: "The version range in NuGet or Maven syntax, such as `[1.0,2.0)`.",
+			},
+			function.StringParameter{
+				Name:        "tag",
+				Description: "The regular expression matched against the pre-release tag.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *versionMatchesChannelRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version, versionRange, tag string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &version, &versionRange, &tag))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := channelrules.ParseVersion(version); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if _, err := channelrules.ParseRange(versionRange); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	if err := (channelrules.Rule{Tag: tag}).Validate(); err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	matches, err := channelrules.Rule{VersionRange: versionRange, Tag: tag}.Matches(version)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, matches))
+}
@@ -0,0 +1,44 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func runVersionMatchesChannelRule(version string, versionRange string, tag string) function.RunResponse {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.StringValue(version),
+		types.StringValue(versionRange),
+		types.StringValue(tag),
+	})}
+	resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+
+	NewVersionMatchesChannelRuleFunction().Run(context.Background(), req, &resp)
+	return resp
+}
+
+func TestVersionMatchesChannelRuleFunction(t *testing.T) {
+	resp := runVersionMatchesChannelRule("1.4.0", "[1.0,2.0)", "^$")
+	require.Nil(t, resp.Error)
+	require.Equal(t, types.BoolValue(true), resp.Result.Value())
+
+	resp = runVersionMatchesChannelRule("1.4.0-beta.1", "[1.0,2.0)", "^$")
+	require.Nil(t, resp.Error)
+	require.Equal(t, types.BoolValue(false), resp.Result.Value())
+}
+
+func TestVersionMatchesChannelRuleFunctionRejectsInvalidArguments(t *testing.T) {
+	for argument, resp := range map[int64]function.RunResponse{
+		0: runVersionMatchesChannelRule("latest", "", ""),
+		1: runVersionMatchesChannelRule("1.0", "[1.0,", ""),
+		2: runVersionMatchesChannelRule("1.0", "", "beta("),
+	} {
+		require.NotNil(t, resp.Error)
+		require.Equal(t, argument, *resp.Error.FunctionArgument)
+	}
+}
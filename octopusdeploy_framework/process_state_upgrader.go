@@ -0,0 +1,106 @@
+package octopusdeploy_framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeProcessStateFromSDK upgrades the state of a deployment or runbook process saved by the SDKv2 resource, whose
+// blocks have the same names and nesting as the current schema. SDKv2 saves unset optional attributes as zero values,
+// which are kept as they are, since they can't be told apart from attributes set to a zero value. The process schemas
+// plan such a value from the state when the attribute is not configured, so neither configuration shows changes.
+func upgradeProcessStateFromSDK(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("unable to upgrade process state", "the state has no values to upgrade")
+		return
+	}
+
+	schema, ok := resp.State.Schema.(resourceSchema.Schema)
+	if !ok {
+		resp.Diagnostics.AddError("unable to upgrade process state", "the schema of the resource is not a resource schema")
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	var values map[string]any
+	if err := decoder.Decode(&values); err != nil {
+		resp.Diagnostics.AddError("unable to upgrade process state", err.Error())
+		return
+	}
+
+	upgradeSDKValues(values, schema.Blocks)
+
+	upgraded, err := json.Marshal(values)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to upgrade process state", err.Error())
+		return
+	}
+
+	raw, err := (&tfprotov6.RawState{JSON: upgraded}).UnmarshalWithOpts(schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to upgrade process state", err.Error())
+		return
+	}
+
+	resp.State.Raw = raw
+}
+
+// upgradeSDKValues changes the blocks of an object saved by SDKv2 to match the blocks of the current schema. Blocks are
+// never null, and containers without a feed or image, which SDKv2 saved for every action, are removed.
+func upgradeSDKValues(values map[string]any, blocks map[string]resourceSchema.Block) {
+	for name, block := range blocks {
+		var nested resourceSchema.NestedBlockObject
+		switch b := block.(type) {
+		case resourceSchema.ListNestedBlock:
+			nested = b.NestedObject
+		case resourceSchema.SetNestedBlock:
+			nested = b.NestedObject
+		default:
+			continue
+		}
+
+		elements, _ := values[name].([]any)
+		upgraded := make([]any, 0, len(elements))
+		for _, element := range elements {
+			object, ok := element.(map[string]any)
+			if !ok {
+				continue
+			}
+			if name == "container" && isZeroSDKValue(object["feed_id"]) && isZeroSDKValue(object["image"]) {
+				continue
+			}
+
+			upgradeSDKValues(object, nested.Blocks)
+			upgraded = append(upgraded, object)
+		}
+		values[name] = upgraded
+	}
+}
+
+func isZeroSDKValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type deploymentResource struct {
+	*Config
+}
+
+func NewDeploymentResource() resource.Resource {
+	return &deploymentResource{}
+}
+
+func (*deploymentResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.DeploymentResourceDescription)
+}
+
+func (*deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.DeploymentSchema{}.GetResourceSchema()
+}
+
+func (r *deploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create deploys the release, and records the deployments in state before waiting for them, so a deployment that
+// fails or times out is tainted and deployed again by the next apply.
+func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.DeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := parseServerTaskTimeout(plan.Timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "invalid timeout", err.Error())
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = r.Client.GetSpaceID()
+	}
+
+	release, err := newclient.GetByID[releases.Release](r.Client, uritemplates.Releases, spaceID, plan.ReleaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load release %s", plan.ReleaseID.ValueString()), err.Error())
+		return
+	}
+
+	util.Create(ctx, schemas.DeploymentResourceDescription, plan)
+
+	response, diags := r.deploy(ctx, plan, spaceID, release)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deploymentIDs, taskIDs []string
+	for _, task := range response.DeploymentServerTasks {
+		deploymentIDs = append(deploymentIDs, task.DeploymentID)
+		taskIDs = append(taskIDs, task.ServerTaskID)
+	}
+	if len(deploymentIDs) == 0 {
+		resp.Diagnostics.AddError("unable to create deployment", "Octopus Deploy did not create any deployments")
+		return
+	}
+
+	plan.ID = types.StringValue(deploymentIDs[0])
+	plan.SpaceID = types.StringValue(spaceID)
+	plan.DeploymentIDs = util.FlattenStringList(deploymentIDs)
+	plan.TaskIDs = util.FlattenStringList(taskIDs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(waitForServerTasks(ctx, r.Client, spaceID, taskIDs, timeout, schemas.DeploymentResourceDescription)...)
+	}
+
+	util.Created(ctx, schemas.DeploymentResourceDescription, plan)
+}
+
+func (r *deploymentResource) deploy(ctx context.Context, plan schemas.DeploymentResourceModel, spaceID string, release *releases.Release) (*deployments.CreateDeploymentResponseV1, diag.Diagnostics) {
+	execution, diags := expandExecutionCommand(ctx, plan.ServerTaskExecutionModel, spaceID, release.ProjectID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var response *deployments.CreateDeploymentResponseV1
+	var err error
+	if plan.TenantIDs.IsNull() {
+		command := &deployments.CreateDeploymentUntenantedCommandV1{
+			ReleaseVersion:                   release.Version,
+			EnvironmentNames:                 []string{plan.EnvironmentID.ValueString()},
+			CreateExecutionAbstractCommandV1: execution,
+		}
+		response, err = deployments.CreateDeploymentUntenantedV1(r.Client, command)
+	} else {
+		command := &deployments.CreateDeploymentTenantedCommandV1{
+			ReleaseVersion:                   release.Version,
+			EnvironmentName:                  plan.EnvironmentID.ValueString(),
+			CreateExecutionAbstractCommandV1: execution,
+		}
+		diags.Append(plan.TenantIDs.ElementsAs(ctx, &command.Tenants, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		response, err = deployments.CreateDeploymentTenantedV1(r.Client, command)
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("unable to deploy release %s", release.Version), err.Error())
+	}
+	return response, diags
+}
+
+// Read keeps the state as it is: deployments are removed by retention policies, and a deployment that has been
+// cleaned up must not be deployed again.
+func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.DeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records the plan: every attribute that describes the deployment requires it to be replaced, and the
+// remaining attributes only control how Create waits for the deployment.
+func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.DeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the deployment from state only; it stays in the deployment history of the project.
+func (r *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.DeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Deleted(ctx, schemas.DeploymentResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,206 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState  = &deploymentProcessResource{}
+	_ resource.ResourceWithUpgradeState = &deploymentProcessResource{}
+)
+
+// deploymentProcessImportID matches the IDs given to deployment processes by this resource: the ID of the project,
+// followed by the branch for version-controlled projects, such as deploymentprocess-Projects-1-main.
+var deploymentProcessImportID = regexp.MustCompile(`(Projects-\d+)(?:-(.+))?$`)
+
+type deploymentProcessResource struct {
+	*Config
+}
+
+func NewDeploymentProcessResource() resource.Resource {
+	return &deploymentProcessResource{}
+}
+
+func (*deploymentProcessResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.DeploymentProcessResourceDescription)
+}
+
+func (*deploymentProcessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.DeploymentProcessSchema{}.GetResourceSchema()
+}
+
+func (r *deploymentProcessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create replaces the steps of the deployment process every project already has.
+func (r *deploymentProcessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.DeploymentProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.DeploymentProcessResourceDescription, plan)
+
+	process, branch, err := r.saveDeploymentProcess(ctx, plan, plan.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create deployment process", err.Error())
+		return
+	}
+
+	state := flattenDeploymentProcess(process, branch, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Created(ctx, schemas.DeploymentProcessResourceDescription, state)
+}
+
+func (r *deploymentProcessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.DeploymentProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.DeploymentProcessResourceDescription, state)
+
+	process, err := getDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.DeploymentProcessResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load deployment process", err.Error())
+		}
+		return
+	}
+
+	newState := flattenDeploymentProcess(process, process.Branch, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Read(ctx, schemas.DeploymentProcessResourceDescription, newState)
+}
+
+func (r *deploymentProcessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.DeploymentProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.DeploymentProcessResourceDescription, plan)
+
+	branch := state.Branch.ValueString()
+	if !plan.Branch.IsUnknown() && plan.Branch.ValueString() != branch {
+		// An empty branch is likely a process saved before its project was converted to version control.
+		if branch != "" {
+			resp.Diagnostics.AddError("unable to update deployment process", "you cannot change a deployment processes branch. instead create a new resource with the new branch and, if required, destroy the previous one")
+			return
+		}
+		branch = plan.Branch.ValueString()
+	}
+
+	process, branch, err := r.saveDeploymentProcess(ctx, plan, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to update deployment process", err.Error())
+		return
+	}
+
+	newState := flattenDeploymentProcess(process, branch, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Updated(ctx, schemas.DeploymentProcessResourceDescription, newState)
+}
+
+// Delete removes the steps of the deployment process, as the process itself belongs to the project.
+func (r *deploymentProcessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.DeploymentProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.DeploymentProcessResourceDescription, state)
+
+	spaceID := processSpaceID(state.SpaceID, r.Client.GetSpaceID())
+	_, err := updateDeploymentProcess(r.Client, spaceID, state.ProjectID.ValueString(), state.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		process.Steps = []*deployments.DeploymentStep{}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to delete deployment process", err.Error())
+		return
+	}
+
+	util.Deleted(ctx, schemas.DeploymentProcessResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts the ID of a deployment process, such as deploymentprocess-Projects-1, or
+// deploymentprocess-Projects-1-main for a branch of a version-controlled project.
+func (r *deploymentProcessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	match := deploymentProcessImportID.FindStringSubmatch(req.ID)
+	if match == nil {
+		resp.Diagnostics.AddError(
+			"Incorrect Import Format",
+			"ID must be the ID of a deployment process, optionally followed by a branch (e.g. deploymentprocess-Projects-1 or deploymentprocess-Projects-1-main)",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), match[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), match[2])...)
+}
+
+func (*deploymentProcessResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeProcessStateFromSDK},
+	}
+}
+
+// saveDeploymentProcess replaces the steps of the deployment process on a branch with the planned steps. It returns
+// the saved process and the branch it was saved to, which is the default branch of a version-controlled project when
+// branch is empty.
+func (r *deploymentProcessResource) saveDeploymentProcess(ctx context.Context, plan schemas.DeploymentProcessResourceModel, branch string) (*deployments.DeploymentProcess, string, error) {
+	spaceID := processSpaceID(plan.SpaceID, r.Client.GetSpaceID())
+	process, err := updateDeploymentProcess(r.Client, spaceID, plan.ProjectID.ValueString(), branch, func(process *deployments.DeploymentProcess) error {
+		branch = process.Branch
+		if plan.LastSnapshotID.ValueString() != "" {
+			process.LastSnapshotID = plan.LastSnapshotID.ValueString()
+		}
+		process.Steps = expandDeploymentSteps(ctx, plan.Steps, process.Steps)
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return process, branch, nil
+}
+
+// flattenDeploymentProcess returns the state of a deployment process. Processes of version-controlled projects have no
+// ID of their own, so they are identified by their project and branch.
+func flattenDeploymentProcess(process *deployments.DeploymentProcess, branch string, prior schemas.DeploymentProcessResourceModel) schemas.DeploymentProcessResourceModel {
+	state := schemas.DeploymentProcessResourceModel{
+		Branch:         types.StringValue(branch),
+		LastSnapshotID: types.StringValue(process.LastSnapshotID),
+		ProjectID:      prior.ProjectID,
+		SpaceID:        types.StringValue(process.SpaceID),
+		Steps:          flattenDeploymentSteps(process.Steps, prior.Steps),
+		Version:        types.Int64Value(int64(process.Version)),
+	}
+
+	state.ID = types.StringValue(process.GetID())
+	if branch != "" || process.GetID() == "" {
+		state.ID = types.StringValue(fmt.Sprintf("deploymentprocess-%s-%s", prior.ProjectID.ValueString(), branch))
+	}
+	return state
+}
@@ -0,0 +1,87 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestDeploymentProcessResource_UpgradeFromSDK_ToPluginFramework(t *testing.T) {
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testRunbookDestroyed,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"octopusdeploy": {
+						VersionConstraint: "0.22.0",
+						Source:            "OctopusDeployLabs/octopusdeploy",
+					},
+				},
+				Config: processesConfig(name),
+			},
+			{
+				ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+				Config:                   processesConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("octopusdeploy_deployment_process.process1", "NoOp"),
+						plancheck.ExpectResourceAction("octopusdeploy_runbook_process.process1", "NoOp"),
+					},
+				},
+			},
+		},
+	})
+}
+
+func processesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "octopusdeploy_project" "project1" {
+		name = "project %[1]s"
+		lifecycle_id = "Lifecycles-1"
+		project_group_id = "ProjectGroups-1"
+	}
+	resource "octopusdeploy_runbook" "runbook1" {
+		project_id = octopusdeploy_project.project1.id
+		name = "runbook %[1]s"
+	}
+	resource "octopusdeploy_deployment_process" "process1" {
+		project_id = octopusdeploy_project.project1.id
+		step {
+			name = "Hello"
+			target_roles = ["web"]
+			run_script_action {
+				name = "Hello"
+				run_on_server = true
+				script_body = "echo hello"
+			}
+		}
+		step {
+			name = "Approve"
+			manual_intervention_action {
+				name = "Approve"
+				instructions = "Approve the deployment"
+			}
+		}
+	}
+	resource "octopusdeploy_runbook_process" "process1" {
+		runbook_id = octopusdeploy_runbook.runbook1.id
+		step {
+			name = "Hello"
+			action {
+				name = "Hello"
+				action_type = "Octopus.Script"
+				run_on_server = true
+				properties = {
+					"Octopus.Action.Script.ScriptSource" = "Inline"
+					"Octopus.Action.Script.Syntax" = "Bash"
+					"Octopus.Action.Script.ScriptBody" = "echo hello"
+				}
+			}
+		}
+	}
+	`, name)
+}
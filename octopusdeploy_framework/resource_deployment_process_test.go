@@ -0,0 +1,227 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+)
+
+// sdkDeploymentProcessState is the state the SDKv2 resource saved for a process with one script step.
+const sdkDeploymentProcessState = `{
+	"id": "deploymentprocess-Projects-1",
+	"branch": "",
+	"last_snapshot_id": "",
+	"project_id": "Projects-1",
+	"space_id": "Spaces-1",
+	"version": 3,
+	"step": [{
+		"condition": "Success",
+		"condition_expression": "",
+		"id": "Steps-1",
+		"name": "Hello",
+		"package_requirement": "LetOctopusDecide",
+		"properties": {"Octopus.Action.TargetRoles": "web"},
+		"start_trigger": "StartAfterPrevious",
+		"target_roles": ["web"],
+		"window_size": "",
+		"action": [],
+		"run_script_action": [{
+			"action_template": [],
+			"can_be_used_for_project_versioning": false,
+			"channels": [],
+			"condition": "Success",
+			"container": [{"feed_id": "", "image": ""}],
+			"environments": [],
+			"excluded_environments": [],
+			"features": [],
+			"git_dependency": [],
+			"id": "Actions-1",
+			"is_disabled": false,
+			"is_required": false,
+			"name": "Hello",
+			"notes": "",
+			"package": [],
+			"primary_package": [],
+			"properties": {"Octopus.Action.Script.ScriptBody": "echo hello"},
+			"run_on_server": true,
+			"script_body": "echo hello",
+			"script_file_name": "",
+			"script_parameters": "",
+			"script_source": "Inline",
+			"script_syntax": "Bash",
+			"slug": "hello",
+			"sort_order": 1,
+			"tenant_tags": [],
+			"variable_substitution_in_files": "",
+			"worker_pool_id": "WorkerPools-1",
+			"worker_pool_variable": ""
+		}]
+	}]
+}`
+
+func TestUpgradeProcessStateFromSDK(t *testing.T) {
+	ctx := context.Background()
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(sdkDeploymentProcessState)}}
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemas.DeploymentProcessSchema{}.GetResourceSchema()}}
+
+	upgradeProcessStateFromSDK(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state schemas.DeploymentProcessResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	require.Equal(t, "deploymentprocess-Projects-1", state.ID.ValueString())
+	require.Equal(t, int64(3), state.Version.ValueInt64())
+	require.Len(t, state.Steps, 1)
+
+	step := state.Steps[0]
+	require.Equal(t, "", step.WindowSize.ValueString())
+	require.Equal(t, "", step.ConditionExpression.ValueString())
+	require.Empty(t, step.Actions)
+	require.NotNil(t, step.ManualInterventionActions)
+	require.Len(t, step.RunScriptActions, 1)
+
+	action := step.RunScriptActions[0]
+	require.Equal(t, "echo hello", action.ScriptBody.ValueString())
+	require.Equal(t, int64(1), action.SortOrder.ValueInt64())
+	require.Equal(t, "", action.Notes.ValueString())
+	require.Equal(t, "", action.ScriptFileName.ValueString())
+	require.Equal(t, "", action.WorkerPoolVariable.ValueString())
+	require.Equal(t, "WorkerPools-1", action.WorkerPoolID.ValueString())
+	require.Empty(t, action.Container)
+}
+
+func TestUpgradeProcessStateFromSDKKeepsFalse(t *testing.T) {
+	ctx := context.Background()
+	sdkState := `{
+		"id": "deploymentprocess-Projects-1",
+		"project_id": "Projects-1",
+		"space_id": "Spaces-1",
+		"step": [{
+			"name": "Apply",
+			"apply_terraform_template_action": [{
+				"name": "Apply",
+				"run_on_server": true,
+				"container": [{"feed_id": "", "image": ""}],
+				"template": [{
+					"additional_variable_files": "",
+					"directory": "",
+					"run_automatic_file_substitution": false,
+					"target_files": ""
+				}]
+			}]
+		}]
+	}`
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(sdkState)}}
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemas.DeploymentProcessSchema{}.GetResourceSchema()}}
+
+	upgradeProcessStateFromSDK(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state schemas.DeploymentProcessResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	require.Len(t, state.Steps, 1)
+	require.Len(t, state.Steps[0].ApplyTerraformTemplateActions, 1)
+
+	action := state.Steps[0].ApplyTerraformTemplateActions[0]
+	require.Empty(t, action.Container)
+	require.Len(t, action.Template, 1)
+	require.False(t, action.Template[0].RunAutomaticFileSubstitution.IsNull(), "a false value set in the configuration must not become null")
+	require.False(t, action.Template[0].RunAutomaticFileSubstitution.ValueBool())
+}
+
+func TestExpandDeploymentStepsKeepsStepIDs(t *testing.T) {
+	current := []*deployments.DeploymentStep{
+		newTestDeploymentStep("Steps-1", "Build", "build"),
+		newTestDeploymentStep("Steps-2", "Test", "test"),
+		newTestDeploymentStep("Steps-3", "Deploy", "deploy"),
+	}
+	planned := []schemas.DeploymentStepModel{
+		newTestDeploymentStepModel("Deploy"),
+		newTestDeploymentStepModel("Integration Test"),
+		newTestDeploymentStepModel("Notify"),
+	}
+
+	steps := expandDeploymentSteps(context.Background(), planned, current)
+	require.Len(t, steps, 3)
+	require.Equal(t, "Steps-3", steps[0].GetID())
+	require.Equal(t, "Steps-2", steps[1].GetID(), "a renamed step keeps the ID of the step at its position")
+	require.Equal(t, "", steps[2].GetID())
+}
+
+func TestSortDeploymentActions(t *testing.T) {
+	current := newTestDeploymentStep("Steps-1", "First", "first")
+	current.Actions = append(current.Actions, deployments.NewDeploymentAction("Second", "Octopus.Script"))
+
+	step := deployments.NewDeploymentStep("First")
+	for _, name := range []string{"New", "Second", "First"} {
+		step.Actions = append(step.Actions, deployments.NewDeploymentAction(name, "Octopus.Script"))
+	}
+
+	sortDeploymentActions(context.Background(), step, current, map[string]int64{})
+	require.Equal(t, []string{"First", "Second", "New"}, deploymentActionNames(step))
+
+	sortDeploymentActions(context.Background(), step, current, map[string]int64{"First": 3, "Second": 2, "New": 1})
+	require.Equal(t, []string{"New", "Second", "First"}, deploymentActionNames(step))
+}
+
+func TestFlattenDeploymentStepKeepsActionsInConfiguredBlocks(t *testing.T) {
+	step := deployments.NewDeploymentStep("Scripts")
+	step.ID = "Steps-1"
+	step.Actions = append(step.Actions,
+		deployments.NewDeploymentAction("Generic", "Octopus.Script"),
+		deployments.NewDeploymentAction("Typed", "Octopus.Script"),
+		deployments.NewDeploymentAction("Transfer", "Octopus.TransferPackage"),
+	)
+
+	prior := newTestDeploymentStepModel("Scripts")
+	prior.Actions = []schemas.DeploymentActionModel{{DeploymentActionBaseModel: schemas.DeploymentActionBaseModel{Name: types.StringValue("Generic")}}}
+
+	flattened := flattenDeploymentStep(step, &prior)
+	require.Equal(t, "Steps-1", flattened.ID.ValueString())
+	require.Len(t, flattened.Actions, 2)
+	require.Equal(t, "Generic", flattened.Actions[0].Name.ValueString())
+	require.Equal(t, "Transfer", flattened.Actions[1].Name.ValueString())
+	require.Equal(t, int64(3), flattened.Actions[1].SortOrder.ValueInt64())
+	require.Len(t, flattened.RunScriptActions, 1)
+	require.Equal(t, "Typed", flattened.RunScriptActions[0].Name.ValueString())
+	require.NotNil(t, flattened.DeployPackageActions)
+}
+
+func TestDeploymentProcessImportID(t *testing.T) {
+	match := deploymentProcessImportID.FindStringSubmatch("deploymentprocess-Projects-12")
+	require.Equal(t, []string{"Projects-12", ""}, match[1:])
+
+	match = deploymentProcessImportID.FindStringSubmatch("deploymentprocess-Projects-12-feature-branch")
+	require.Equal(t, []string{"Projects-12", "feature-branch"}, match[1:])
+
+	require.Nil(t, deploymentProcessImportID.FindStringSubmatch("deploymentprocess-12"))
+}
+
+func newTestDeploymentStepModel(name string) schemas.DeploymentStepModel {
+	return schemas.DeploymentStepModel{
+		Condition:           types.StringValue("Success"),
+		ConditionExpression: types.StringUnknown(),
+		ID:                  types.StringUnknown(),
+		Name:                types.StringValue(name),
+		PackageRequirement:  types.StringValue("LetOctopusDecide"),
+		Properties:          types.MapUnknown(types.StringType),
+		StartTrigger:        types.StringValue("StartAfterPrevious"),
+		TargetRoles:         types.ListUnknown(types.StringType),
+		WindowSize:          types.StringNull(),
+	}
+}
+
+func deploymentActionNames(step *deployments.DeploymentStep) []string {
+	names := make([]string, 0, len(step.Actions))
+	for _, action := range step.Actions {
+		names = append(names, action.Name)
+	}
+	return names
+}
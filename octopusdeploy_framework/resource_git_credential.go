@@ -28,6 +28,7 @@ type gitCredentialResourceModel struct {
 	Password    types.String `tfsdk:"password"`
 
 	schemas.ResourceModel
+	schemas.PasswordWriteOnlyModel
 }
 
 func NewGitCredentialResource() resource.Resource {
@@ -0,0 +1,245 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &processChildStepResource{}
+
+type processChildStepResource struct {
+	*Config
+}
+
+func NewProcessChildStepResource() resource.Resource {
+	return &processChildStepResource{}
+}
+
+func (*processChildStepResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ProcessChildStepResourceDescription)
+}
+
+func (*processChildStepResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ProcessChildStepSchema{}.GetResourceSchema()
+}
+
+func (r *processChildStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create adds the child step after the existing child steps of its parent step.
+func (r *processChildStepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.ProcessChildStepResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.ProcessChildStepResourceDescription, plan)
+
+	spaceID := processSpaceID(plan.SpaceID, r.Client.GetSpaceID())
+	name := plan.Name.ValueString()
+
+	var diags diag.Diagnostics
+	process, err := updateDeploymentProcess(r.Client, spaceID, plan.ProjectID.ValueString(), plan.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		parent := findDeploymentStep(process, plan.ParentID.ValueString(), "")
+		if parent == nil {
+			return fmt.Errorf("the deployment process does not contain the parent step %s", plan.ParentID.ValueString())
+		}
+		if findDeploymentActionByName(process, name) != nil {
+			return fmt.Errorf("the deployment process already contains a step named %s", name)
+		}
+
+		action := deployments.NewDeploymentAction(name, plan.Type.ValueString())
+		diags = expandProcessAction(ctx, action, plan.ProcessActionModel, schemas.ProcessActionModel{})
+		parent.Actions = append(parent.Actions, action)
+		return diagnosticsError(diags)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create process child step", err.Error())
+		return
+	}
+
+	action := findDeploymentActionByName(process, name)
+	if action == nil {
+		resp.Diagnostics.AddError("unable to create process child step", fmt.Sprintf("the child step %s was not added to the deployment process", name))
+		return
+	}
+
+	state, diags := flattenProcessChildStep(ctx, action, plan)
+	resp.Diagnostics.Append(diags...)
+	state.SpaceID = types.StringValue(spaceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Created(ctx, schemas.ProcessChildStepResourceDescription, state)
+}
+
+func (r *processChildStepResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.ProcessChildStepResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.ProcessChildStepResourceDescription, state)
+
+	process, err := getDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.ProcessChildStepResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load deployment process", err.Error())
+		}
+		return
+	}
+
+	var action *deployments.DeploymentAction
+	if parent := findDeploymentStep(process, state.ParentID.ValueString(), ""); parent != nil {
+		action = findChildDeploymentAction(parent, state.ID.ValueString(), state.Slug.ValueString())
+	}
+	if action == nil {
+		resp.Diagnostics.Append(diag.NewWarningDiagnostic("process child step not found", fmt.Sprintf("the child step %s is no longer in the deployment process, and will be created again", state.Name.ValueString())))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, diags := flattenProcessChildStep(ctx, action, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Read(ctx, schemas.ProcessChildStepResourceDescription, newState)
+}
+
+func (r *processChildStepResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.ProcessChildStepResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.ProcessChildStepResourceDescription, plan)
+
+	spaceID := processSpaceID(state.SpaceID, r.Client.GetSpaceID())
+
+	var diags diag.Diagnostics
+	process, err := updateDeploymentProcess(r.Client, spaceID, state.ProjectID.ValueString(), state.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		parent := findDeploymentStep(process, state.ParentID.ValueString(), "")
+		if parent == nil {
+			return fmt.Errorf("the deployment process no longer contains the parent step %s", state.ParentID.ValueString())
+		}
+		action := findChildDeploymentAction(parent, state.ID.ValueString(), state.Slug.ValueString())
+		if action == nil {
+			return fmt.Errorf("the deployment process no longer contains the child step %s", state.Name.ValueString())
+		}
+
+		action.Name = plan.Name.ValueString()
+		diags = expandProcessAction(ctx, action, plan.ProcessActionModel, state.ProcessActionModel)
+		return diagnosticsError(diags)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to update process child step", err.Error())
+		return
+	}
+
+	action := findDeploymentActionByName(process, plan.Name.ValueString())
+	if action == nil {
+		resp.Diagnostics.AddError("unable to update process child step", fmt.Sprintf("the child step %s is missing from the updated deployment process", plan.Name.ValueString()))
+		return
+	}
+
+	newState, diags := flattenProcessChildStep(ctx, action, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Updated(ctx, schemas.ProcessChildStepResourceDescription, newState)
+}
+
+func (r *processChildStepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.ProcessChildStepResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.ProcessChildStepResourceDescription, state)
+
+	_, err := updateDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		parent := findDeploymentStep(process, state.ParentID.ValueString(), "")
+		if parent == nil {
+			return nil
+		}
+		if action := findChildDeploymentAction(parent, state.ID.ValueString(), state.Slug.ValueString()); action != nil {
+			removeDeploymentAction(parent, action)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to delete process child step", err.Error())
+		return
+	}
+
+	util.Deleted(ctx, schemas.ProcessChildStepResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts a project ID or slug, the slug of the parent step and the slug of the child step, separated by
+// slashes, such as my-project/deploy-web/smoke-test. Child steps of version-controlled projects are imported from the
+// default branch.
+func (r *processChildStepResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identifiers := strings.Split(req.ID, "/")
+	if len(identifiers) != 3 || identifiers[0] == "" || identifiers[1] == "" || identifiers[2] == "" {
+		resp.Diagnostics.AddError(
+			"Incorrect Import Format",
+			"ID must be in the format: ProjectSlug/StepSlug/ChildStepSlug (e.g. my-project/deploy-web/smoke-test)",
+		)
+		return
+	}
+
+	project, parent, err := findStepInProject(r.Client, r.Client.GetSpaceID(), identifiers[0], identifiers[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to import process child step %s", req.ID), err.Error())
+		return
+	}
+
+	action := findChildDeploymentAction(parent, "", identifiers[2])
+	if action == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to import process child step %s", req.ID), fmt.Sprintf("the step %s has no child step with the slug %s", parent.Name, identifiers[2]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), action.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), action.Slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), action.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_id"), parent.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), project.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), project.SpaceID)...)
+}
+
+// flattenProcessChildStep returns the state of a child step, keeping the attributes that are not read from the action,
+// such as the parent step, from prior.
+func flattenProcessChildStep(ctx context.Context, action *deployments.DeploymentAction, prior schemas.ProcessChildStepResourceModel) (schemas.ProcessChildStepResourceModel, diag.Diagnostics) {
+	state := prior
+	state.ID = types.StringValue(action.GetID())
+	state.Name = types.StringValue(action.Name)
+
+	var diags diag.Diagnostics
+	state.ProcessActionModel, diags = flattenProcessAction(ctx, action, prior.ProcessActionModel)
+	return state, diags
+}
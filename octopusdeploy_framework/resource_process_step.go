@@ -0,0 +1,501 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.ResourceWithImportState = &processStepResource{}
+
+type processStepResource struct {
+	*Config
+}
+
+func NewProcessStepResource() resource.Resource {
+	return &processStepResource{}
+}
+
+func (*processStepResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ProcessStepResourceDescription)
+}
+
+func (*processStepResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ProcessStepSchema{}.GetResourceSchema()
+}
+
+func (r *processStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create adds the step to the end of the deployment process.
+func (r *processStepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.ProcessStepResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.ProcessStepResourceDescription, plan)
+
+	spaceID := processSpaceID(plan.SpaceID, r.Client.GetSpaceID())
+	name := plan.Name.ValueString()
+
+	var diags diag.Diagnostics
+	process, err := updateDeploymentProcess(r.Client, spaceID, plan.ProjectID.ValueString(), plan.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		if findDeploymentStepByName(process, name) != nil || findDeploymentActionByName(process, name) != nil {
+			return fmt.Errorf("the deployment process already contains a step named %s", name)
+		}
+
+		step := deployments.NewDeploymentStep(name)
+		diags = expandProcessStep(ctx, step, plan, schemas.ProcessStepResourceModel{})
+		process.Steps = append(process.Steps, step)
+		return diagnosticsError(diags)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create process step", err.Error())
+		return
+	}
+
+	step := findDeploymentStepByName(process, name)
+	if step == nil {
+		resp.Diagnostics.AddError("unable to create process step", fmt.Sprintf("the step %s was not added to the deployment process", name))
+		return
+	}
+
+	state, diags := flattenProcessStep(ctx, step, plan)
+	resp.Diagnostics.Append(diags...)
+	state.SpaceID = types.StringValue(spaceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Created(ctx, schemas.ProcessStepResourceDescription, state)
+}
+
+func (r *processStepResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.ProcessStepResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.ProcessStepResourceDescription, state)
+
+	process, err := getDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.ProcessStepResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load deployment process", err.Error())
+		}
+		return
+	}
+
+	step := findDeploymentStep(process, state.ID.ValueString(), state.Slug.ValueString())
+	if step == nil {
+		resp.Diagnostics.Append(diag.NewWarningDiagnostic("process step not found", fmt.Sprintf("the step %s is no longer in the deployment process, and will be created again", state.Name.ValueString())))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, diags := flattenProcessStep(ctx, step, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Read(ctx, schemas.ProcessStepResourceDescription, newState)
+}
+
+func (r *processStepResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.ProcessStepResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.ProcessStepResourceDescription, plan)
+
+	spaceID := processSpaceID(state.SpaceID, r.Client.GetSpaceID())
+
+	var stepID string
+	var diags diag.Diagnostics
+	process, err := updateDeploymentProcess(r.Client, spaceID, state.ProjectID.ValueString(), state.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		step := findDeploymentStep(process, state.ID.ValueString(), state.Slug.ValueString())
+		if step == nil {
+			return fmt.Errorf("the deployment process no longer contains the step %s", state.Name.ValueString())
+		}
+
+		stepID = step.GetID()
+		diags = expandProcessStep(ctx, step, plan, state)
+		return diagnosticsError(diags)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("unable to update process step", err.Error())
+		return
+	}
+
+	step := findDeploymentStep(process, stepID, "")
+	if step == nil {
+		step = findDeploymentStepByName(process, plan.Name.ValueString())
+	}
+	if step == nil {
+		resp.Diagnostics.AddError("unable to update process step", fmt.Sprintf("the step %s is missing from the updated deployment process", plan.Name.ValueString()))
+		return
+	}
+
+	newState, diags := flattenProcessStep(ctx, step, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Updated(ctx, schemas.ProcessStepResourceDescription, newState)
+}
+
+// Delete removes the step, and any child steps it still has, from the deployment process.
+func (r *processStepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.ProcessStepResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.ProcessStepResourceDescription, state)
+
+	_, err := updateDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		if step := findDeploymentStep(process, state.ID.ValueString(), state.Slug.ValueString()); step != nil {
+			removeDeploymentStep(process, step)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to delete process step", err.Error())
+		return
+	}
+
+	util.Deleted(ctx, schemas.ProcessStepResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts a project ID or slug and the slug of a step, separated by a slash, such as my-project/deploy-web.
+// Steps of version-controlled projects are imported from the default branch.
+func (r *processStepResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identifiers := strings.Split(req.ID, "/")
+	if len(identifiers) != 2 || identifiers[0] == "" || identifiers[1] == "" {
+		resp.Diagnostics.AddError(
+			"Incorrect Import Format",
+			"ID must be in the format: ProjectSlug/StepSlug (e.g. my-project/deploy-web)",
+		)
+		return
+	}
+
+	project, step, err := findStepInProject(r.Client, r.Client.GetSpaceID(), identifiers[0], identifiers[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to import process step %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), step.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), deploymentStepSlug(step))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), step.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), project.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), project.SpaceID)...)
+}
+
+// findStepInProject returns a project, and the step of its deployment process with the slug.
+func findStepInProject(client newclient.Client, spaceID string, projectIDOrSlug string, stepSlug string) (*projects.Project, *deployments.DeploymentStep, error) {
+	project, err := projects.GetByID(client, spaceID, projectIDOrSlug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	process, err := deployments.GetDeploymentProcessByGitRef(client, spaceID, project, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	step := findDeploymentStep(process, "", stepSlug)
+	if step == nil {
+		return nil, nil, fmt.Errorf("the deployment process of project %s has no step with the slug %s", project.Name, stepSlug)
+	}
+	return project, step, nil
+}
+
+// processSpaceID returns the configured space ID, or the space of the provider when none is configured.
+func processSpaceID(spaceID types.String, defaultSpaceID string) string {
+	if spaceID.ValueString() != "" {
+		return spaceID.ValueString()
+	}
+	return defaultSpaceID
+}
+
+// diagnosticsError returns an error when diags has errors, so a change to a deployment process is abandoned before it
+// is saved. The diagnostics themselves are reported by the caller.
+func diagnosticsError(diags diag.Diagnostics) error {
+	if diags.HasError() {
+		return fmt.Errorf("the configuration is not valid")
+	}
+	return nil
+}
+
+// expandProcessStep applies the planned step to a step of the deployment process. prior is the state before the
+// change, and decides which properties are no longer managed and should be removed.
+func expandProcessStep(ctx context.Context, step *deployments.DeploymentStep, plan schemas.ProcessStepResourceModel, prior schemas.ProcessStepResourceModel) diag.Diagnostics {
+	step.Name = plan.Name.ValueString()
+	step.StartTrigger = deployments.DeploymentStepStartTrigger(plan.StartTrigger.ValueString())
+	step.PackageRequirement = deployments.DeploymentStepPackageRequirement(plan.PackageRequirement.ValueString())
+	step.Condition = deployments.DeploymentStepConditionType(plan.Condition.ValueString())
+	step.Properties = mergeManagedProperties(step.Properties, expandStringMap(prior.Properties), expandStringMap(plan.Properties), newPropertyValue)
+
+	setOptionalProperty(step.Properties, schemas.StepConditionExpressionProperty, plan.ConditionExpression.ValueString())
+	setOptionalProperty(step.Properties, schemas.StepWindowSizeProperty, plan.WindowSize.ValueString())
+
+	targetRoles, diags := util.SetToStringArray(ctx, plan.TargetRoles)
+	sort.Strings(targetRoles)
+	setOptionalProperty(step.Properties, schemas.StepTargetRolesProperty, strings.Join(targetRoles, ","))
+
+	if len(step.Actions) == 0 {
+		step.Actions = append(step.Actions, deployments.NewDeploymentAction(step.Name, plan.Type.ValueString()))
+	}
+	step.Actions[0].Name = step.Name
+	diags.Append(expandProcessAction(ctx, step.Actions[0], plan.ProcessActionModel, prior.ProcessActionModel)...)
+	return diags
+}
+
+// flattenProcessStep returns the state of a step, keeping the attributes that are not read from the step, such as the
+// project, from prior.
+func flattenProcessStep(ctx context.Context, step *deployments.DeploymentStep, prior schemas.ProcessStepResourceModel) (schemas.ProcessStepResourceModel, diag.Diagnostics) {
+	state := prior
+	state.ID = types.StringValue(step.GetID())
+	state.Name = types.StringValue(step.Name)
+	state.StartTrigger = types.StringValue(string(step.StartTrigger))
+	state.PackageRequirement = types.StringValue(string(step.PackageRequirement))
+	state.Condition = types.StringValue(string(step.Condition))
+
+	properties := flattenPropertyValues(step.Properties)
+	state.ConditionExpression = util.StringOrNull(properties[schemas.StepConditionExpressionProperty])
+	state.WindowSize = util.StringOrNull(properties[schemas.StepWindowSizeProperty])
+	state.TargetRoles = flattenOptionalStringSet(splitTargetRoles(properties[schemas.StepTargetRolesProperty]), prior.TargetRoles)
+	delete(properties, schemas.StepConditionExpressionProperty)
+	delete(properties, schemas.StepWindowSizeProperty)
+	delete(properties, schemas.StepTargetRolesProperty)
+	state.Properties = flattenManagedProperties(properties, prior.Properties)
+
+	var diags diag.Diagnostics
+	if len(step.Actions) > 0 {
+		state.ProcessActionModel, diags = flattenProcessAction(ctx, step.Actions[0], prior.ProcessActionModel)
+	}
+	state.Slug = types.StringValue(deploymentStepSlug(step))
+	return state, diags
+}
+
+// expandProcessAction applies the planned action of a step or child step to an action of the deployment process.
+func expandProcessAction(ctx context.Context, action *deployments.DeploymentAction, plan schemas.ProcessActionModel, prior schemas.ProcessActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	action.ActionType = plan.Type.ValueString()
+	action.IsDisabled = plan.IsDisabled.ValueBool()
+	action.IsRequired = plan.IsRequired.ValueBool()
+	action.Notes = plan.Notes.ValueString()
+	action.WorkerPool = plan.WorkerPoolID.ValueString()
+	action.WorkerPoolVariable = plan.WorkerPoolVariable.ValueString()
+	action.Properties = mergeManagedProperties(action.Properties, expandStringMap(prior.ExecutionProperties), expandStringMap(plan.ExecutionProperties), newPropertyValue)
+
+	for _, s := range []struct {
+		set    types.Set
+		target *[]string
+	}{
+		{plan.Environments, &action.Environments},
+		{plan.ExcludedEnvironments, &action.ExcludedEnvironments},
+		{plan.Channels, &action.Channels},
+		{plan.TenantTags, &action.TenantTags},
+	} {
+		values, d := util.SetToStringArray(ctx, s.set)
+		diags.Append(d...)
+		*s.target = values
+	}
+
+	plannedPackages, d := expandProcessPackageModels(ctx, plan)
+	diags.Append(d...)
+	priorPackages, d := expandProcessPackageModels(ctx, prior)
+	diags.Append(d...)
+	action.Packages = expandProcessPackages(action.Packages, plannedPackages, priorPackages)
+
+	return diags
+}
+
+// flattenProcessAction returns the state of the action of a step or child step.
+func flattenProcessAction(ctx context.Context, action *deployments.DeploymentAction, prior schemas.ProcessActionModel) (schemas.ProcessActionModel, diag.Diagnostics) {
+	state := schemas.ProcessActionModel{
+		Slug:                 types.StringValue(action.Slug),
+		Type:                 types.StringValue(action.ActionType),
+		IsDisabled:           types.BoolValue(action.IsDisabled),
+		IsRequired:           types.BoolValue(action.IsRequired),
+		Notes:                flattenOptionalString(action.Notes, prior.Notes),
+		WorkerPoolID:         util.StringOrNull(action.WorkerPool),
+		WorkerPoolVariable:   util.StringOrNull(action.WorkerPoolVariable),
+		Environments:         flattenOptionalStringSet(action.Environments, prior.Environments),
+		ExcludedEnvironments: flattenOptionalStringSet(action.ExcludedEnvironments, prior.ExcludedEnvironments),
+		Channels:             flattenOptionalStringSet(action.Channels, prior.Channels),
+		TenantTags:           flattenOptionalStringSet(action.TenantTags, prior.TenantTags),
+		ExecutionProperties:  flattenManagedProperties(flattenPropertyValues(action.Properties), prior.ExecutionProperties),
+	}
+
+	priorPackages, diags := expandProcessPackageModels(ctx, prior)
+	primaryPackage := types.ObjectNull(schemas.ProcessPackageObjectType())
+	otherPackages := map[string]attr.Value{}
+	for _, p := range action.Packages {
+		value, d := types.ObjectValueFrom(ctx, schemas.ProcessPackageObjectType(), flattenProcessPackage(p, priorPackages[p.Name]))
+		diags.Append(d...)
+		if p.Name == "" {
+			primaryPackage = value
+		} else {
+			otherPackages[p.Name] = value
+		}
+	}
+
+	state.PrimaryPackage = primaryPackage
+	state.Packages = types.MapNull(types.ObjectType{AttrTypes: schemas.ProcessPackageObjectType()})
+	if len(otherPackages) > 0 || (!prior.Packages.IsNull() && !prior.Packages.IsUnknown()) {
+		packagesValue, d := types.MapValue(types.ObjectType{AttrTypes: schemas.ProcessPackageObjectType()}, otherPackages)
+		diags.Append(d...)
+		state.Packages = packagesValue
+	}
+	return state, diags
+}
+
+// expandProcessPackageModels returns the packages of a step or child step keyed by the name of the package reference,
+// using an empty name for the primary package.
+func expandProcessPackageModels(ctx context.Context, model schemas.ProcessActionModel) (map[string]schemas.ProcessPackageModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := map[string]schemas.ProcessPackageModel{}
+
+	if !model.Packages.IsNull() && !model.Packages.IsUnknown() {
+		diags.Append(model.Packages.ElementsAs(ctx, &models, false)...)
+	}
+
+	if !model.PrimaryPackage.IsNull() && !model.PrimaryPackage.IsUnknown() {
+		var primaryPackage schemas.ProcessPackageModel
+		diags.Append(model.PrimaryPackage.As(ctx, &primaryPackage, basetypes.ObjectAsOptions{})...)
+		models[""] = primaryPackage
+	}
+	return models, diags
+}
+
+// expandProcessPackages returns the package references of an action, keeping the IDs and unmanaged properties of the
+// package references the action already has. The primary package comes first, followed by the others by name.
+func expandProcessPackages(current []*packages.PackageReference, planned map[string]schemas.ProcessPackageModel, prior map[string]schemas.ProcessPackageModel) []*packages.PackageReference {
+	existing := map[string]*packages.PackageReference{}
+	for _, p := range current {
+		existing[p.Name] = p
+	}
+
+	names := make([]string, 0, len(planned))
+	for name := range planned {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	references := make([]*packages.PackageReference, 0, len(names))
+	for _, name := range names {
+		model := planned[name]
+		reference := &packages.PackageReference{
+			Name:                name,
+			PackageID:           model.PackageID.ValueString(),
+			FeedID:              model.FeedID.ValueString(),
+			AcquisitionLocation: model.AcquisitionLocation.ValueString(),
+		}
+
+		var currentProperties map[string]string
+		if p, ok := existing[name]; ok {
+			reference.ID = p.ID
+			currentProperties = p.Properties
+		}
+		reference.Properties = mergeManagedProperties(currentProperties, expandStringMap(prior[name].Properties), expandStringMap(model.Properties), func(v string) string { return v })
+		references = append(references, reference)
+	}
+	return references
+}
+
+func flattenProcessPackage(reference *packages.PackageReference, prior schemas.ProcessPackageModel) schemas.ProcessPackageModel {
+	return schemas.ProcessPackageModel{
+		PackageID:           types.StringValue(reference.PackageID),
+		FeedID:              types.StringValue(reference.FeedID),
+		AcquisitionLocation: types.StringValue(reference.AcquisitionLocation),
+		Properties:          flattenManagedProperties(reference.Properties, prior.Properties),
+	}
+}
+
+// expandStringMap returns the values of a map attribute, or nil when the map is null or unknown.
+func expandStringMap(value types.Map) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return util.ConvertAttrStringMapToStringMap(value.Elements())
+}
+
+func flattenPropertyValues(properties map[string]core.PropertyValue) map[string]string {
+	values := make(map[string]string, len(properties))
+	for k, v := range properties {
+		values[k] = v.Value
+	}
+	return values
+}
+
+func newPropertyValue(value string) core.PropertyValue {
+	return core.NewPropertyValue(value, false)
+}
+
+// setOptionalProperty sets a property, or removes it when value is empty.
+func setOptionalProperty(properties map[string]core.PropertyValue, key string, value string) {
+	if value == "" {
+		delete(properties, key)
+		return
+	}
+	properties[key] = newPropertyValue(value)
+}
+
+func splitTargetRoles(roles string) []string {
+	var values []string
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			values = append(values, role)
+		}
+	}
+	return values
+}
+
+// flattenOptionalString returns null for an empty value, unless the attribute was set to an empty string.
+func flattenOptionalString(value string, prior types.String) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// flattenOptionalStringSet returns null for no values, unless the attribute was set to an empty set.
+func flattenOptionalStringSet(values []string, prior types.Set) types.Set {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.SetNull(types.StringType)
+	}
+	return types.SetValueMust(types.StringType, util.ToValueSlice(values))
+}
@@ -0,0 +1,168 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDeploymentStep(id string, name string, actionSlug string) *deployments.DeploymentStep {
+	step := deployments.NewDeploymentStep(name)
+	step.ID = id
+	action := deployments.NewDeploymentAction(name, "Octopus.Script")
+	action.Slug = actionSlug
+	step.Actions = append(step.Actions, action)
+	return step
+}
+
+func TestOrderDeploymentSteps(t *testing.T) {
+	steps := []*deployments.DeploymentStep{
+		newTestDeploymentStep("Steps-1", "Build", "build"),
+		newTestDeploymentStep("Steps-2", "Test", "test"),
+		newTestDeploymentStep("Steps-3", "Deploy", "deploy"),
+		newTestDeploymentStep("Steps-4", "Notify", "notify"),
+	}
+
+	ordered, err := orderDeploymentSteps(steps, []string{"Steps-3", "Steps-1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"deploy", "build", "test", "notify"}, flattenDeploymentStepsOrder(ordered, nil))
+	require.Equal(t, []string{"Steps-3", "Steps-1"}, flattenDeploymentStepsOrder(ordered, []string{"Steps-1", "Steps-3"}))
+
+	_, err = orderDeploymentSteps(steps, []string{"Steps-5"})
+	require.ErrorContains(t, err, "does not contain a step with the slug or ID Steps-5")
+
+	_, err = orderDeploymentSteps(steps, []string{"deploy", "Steps-3"})
+	require.ErrorContains(t, err, "the step Steps-3 is listed more than once")
+}
+
+func TestOrderDeploymentStepsBySlug(t *testing.T) {
+	// The IDs of the steps of a version-controlled project change, so they are referenced by slug.
+	steps := []*deployments.DeploymentStep{
+		newTestDeploymentStep("a-regenerated-id", "Build", "build"),
+		newTestDeploymentStep("another-regenerated-id", "Test", "test"),
+		newTestDeploymentStep("Steps-3", "Deploy", "deploy"),
+	}
+
+	ordered, err := orderDeploymentSteps(steps, []string{"deploy", "build"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"deploy", "build", "test"}, flattenDeploymentStepsOrder(ordered, nil))
+	require.Equal(t, []string{"deploy", "build"}, flattenDeploymentStepsOrder(ordered, []string{"build", "deploy"}))
+
+	ordered, err = orderDeploymentSteps(steps, []string{"test", "Steps-3"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test", "Steps-3"}, flattenDeploymentStepsOrder(ordered, []string{"Steps-3", "test"}))
+}
+
+func TestFindDeploymentStepFallsBackToSlug(t *testing.T) {
+	process := &deployments.DeploymentProcess{Steps: []*deployments.DeploymentStep{
+		newTestDeploymentStep("Steps-1", "Deploy Web", "deploy-web"),
+		newTestDeploymentStep("Steps-2", "Smoke Test", ""),
+	}}
+
+	require.Equal(t, "Steps-1", findDeploymentStep(process, "Steps-1", "smoke-test").GetID())
+	require.Equal(t, "Steps-1", findDeploymentStep(process, "a-regenerated-id", "deploy-web").GetID())
+	require.Equal(t, "Steps-2", findDeploymentStep(process, "", "smoke-test").GetID())
+	require.Nil(t, findDeploymentStep(process, "Steps-3", "missing"))
+}
+
+func TestMergeManagedProperties(t *testing.T) {
+	current := map[string]string{"Managed": "old", "Removed": "gone", "Server": "default"}
+
+	merged := mergeManagedProperties(current, map[string]string{"Managed": "old", "Removed": "gone"}, map[string]string{"Managed": "new"}, func(v string) string { return v })
+	require.Equal(t, map[string]string{"Managed": "new", "Server": "default"}, merged)
+
+	unchanged := mergeManagedProperties(current, nil, nil, func(v string) string { return v })
+	require.Equal(t, current, unchanged)
+
+	managed := types.MapValueMust(types.StringType, map[string]attr.Value{"Managed": types.StringValue("new"), "Removed": types.StringValue("gone")})
+	require.Equal(t, map[string]attr.Value{"Managed": types.StringValue("new")}, flattenManagedProperties(merged, managed).Elements())
+	require.Len(t, flattenManagedProperties(merged, types.MapNull(types.StringType)).Elements(), 2)
+}
+
+func TestExpandProcessStepKeepsUnmanagedValues(t *testing.T) {
+	ctx := context.Background()
+	packageType := types.ObjectType{AttrTypes: schemas.ProcessPackageObjectType()}
+
+	step := newTestDeploymentStep("Steps-1", "Deploy Web", "deploy-web")
+	step.Properties["Octopus.Step.Server"] = core.NewPropertyValue("default", false)
+	step.Actions[0].Packages = []*packages.PackageReference{
+		{ID: "Packages-1", PackageID: "web", FeedID: "Feeds-1", AcquisitionLocation: "Server", Properties: map[string]string{"SelectionMode": "immediate"}},
+	}
+
+	plan := schemas.ProcessStepResourceModel{
+		Name:                types.StringValue("Deploy Web"),
+		StartTrigger:        types.StringValue("StartAfterPrevious"),
+		PackageRequirement:  types.StringValue("LetOctopusDecide"),
+		Condition:           types.StringValue("Success"),
+		ConditionExpression: types.StringNull(),
+		TargetRoles:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web"), types.StringValue("api")}),
+		WindowSize:          types.StringValue("2"),
+		Properties:          types.MapUnknown(types.StringType),
+		ProcessActionModel: schemas.ProcessActionModel{
+			Type:                 types.StringValue("Octopus.TentaclePackage"),
+			IsDisabled:           types.BoolValue(false),
+			IsRequired:           types.BoolValue(true),
+			Environments:         types.SetNull(types.StringType),
+			ExcludedEnvironments: types.SetNull(types.StringType),
+			Channels:             types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Channels-1")}),
+			TenantTags:           types.SetNull(types.StringType),
+			PrimaryPackage: types.ObjectValueMust(schemas.ProcessPackageObjectType(), map[string]attr.Value{
+				"package_id":           types.StringValue("web"),
+				"feed_id":              types.StringValue("Feeds-2"),
+				"acquisition_location": types.StringValue("Server"),
+				"properties":           types.MapUnknown(types.StringType),
+			}),
+			Packages:            types.MapNull(packageType),
+			ExecutionProperties: types.MapValueMust(types.StringType, map[string]attr.Value{"Octopus.Action.Package.DownloadOnTentacle": types.StringValue("False")}),
+		},
+	}
+
+	diags := expandProcessStep(ctx, step, plan, schemas.ProcessStepResourceModel{})
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "api,web", step.Properties[schemas.StepTargetRolesProperty].Value)
+	require.Equal(t, "2", step.Properties[schemas.StepWindowSizeProperty].Value)
+	require.Equal(t, "default", step.Properties["Octopus.Step.Server"].Value)
+
+	action := step.Actions[0]
+	require.Equal(t, "Octopus.TentaclePackage", action.ActionType)
+	require.True(t, action.IsRequired)
+	require.Equal(t, []string{"Channels-1"}, action.Channels)
+	require.Equal(t, "False", action.Properties["Octopus.Action.Package.DownloadOnTentacle"].Value)
+	require.Len(t, action.Packages, 1)
+	require.Equal(t, "Packages-1", action.Packages[0].ID)
+	require.Equal(t, "Feeds-2", action.Packages[0].FeedID)
+	require.Equal(t, map[string]string{"SelectionMode": "immediate"}, action.Packages[0].Properties)
+
+	state, diags := flattenProcessStep(ctx, step, plan)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "deploy-web", state.Slug.ValueString())
+	require.Len(t, state.TargetRoles.Elements(), 2)
+	require.True(t, state.ConditionExpression.IsNull())
+	require.Equal(t, map[string]attr.Value{"Octopus.Step.Server": types.StringValue("default")}, state.Properties.Elements())
+	require.True(t, state.Packages.IsNull())
+	require.False(t, state.PrimaryPackage.IsNull())
+}
+
+func TestProcessStepImportRejectsIncompleteIdentifier(t *testing.T) {
+	s := schemas.ProcessStepSchema{}.GetResourceSchema()
+	objectType := s.Type().TerraformType(context.Background())
+
+	for _, id := range []string{"my-project", "my-project/", "/deploy-web", "my-project/deploy-web/smoke-test"} {
+		resp := resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)}}
+		NewProcessStepResource().(*processStepResource).ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+
+		require.True(t, resp.Diagnostics.HasError(), id)
+		require.Equal(t, "Incorrect Import Format", resp.Diagnostics[0].Summary(), id)
+	}
+}
@@ -0,0 +1,142 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &processStepsOrderResource{}
+
+type processStepsOrderResource struct {
+	*Config
+}
+
+func NewProcessStepsOrderResource() resource.Resource {
+	return &processStepsOrderResource{}
+}
+
+func (*processStepsOrderResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ProcessStepsOrderResourceDescription)
+}
+
+func (*processStepsOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ProcessStepsOrderSchema{}.GetResourceSchema()
+}
+
+func (r *processStepsOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+func (r *processStepsOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.ProcessStepsOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.ProcessStepsOrderResourceDescription, plan)
+
+	plan.SpaceID = types.StringValue(processSpaceID(plan.SpaceID, r.Client.GetSpaceID()))
+	if err := r.orderSteps(plan); err != nil {
+		resp.Diagnostics.AddError("unable to order process steps", err.Error())
+		return
+	}
+
+	plan.ID = plan.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	util.Created(ctx, schemas.ProcessStepsOrderResourceDescription, plan)
+}
+
+// Read reports the order of the listed steps that are still in the deployment process, so steps that were moved or
+// removed outside Terraform are ordered again by the next apply.
+func (r *processStepsOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.ProcessStepsOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.ProcessStepsOrderResourceDescription, state)
+
+	process, err := getDeploymentProcess(r.Client, processSpaceID(state.SpaceID, r.Client.GetSpaceID()), state.ProjectID.ValueString(), state.Branch.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.ProcessStepsOrderResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load deployment process", err.Error())
+		}
+		return
+	}
+
+	state.Steps = util.FlattenStringList(flattenDeploymentStepsOrder(process.Steps, util.ExpandStringList(state.Steps)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Read(ctx, schemas.ProcessStepsOrderResourceDescription, state)
+}
+
+func (r *processStepsOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.ProcessStepsOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.ProcessStepsOrderResourceDescription, plan)
+
+	plan.SpaceID = state.SpaceID
+	if err := r.orderSteps(plan); err != nil {
+		resp.Diagnostics.AddError("unable to order process steps", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	util.Updated(ctx, schemas.ProcessStepsOrderResourceDescription, plan)
+}
+
+// Delete removes the order from state only, leaving the steps in their current order.
+func (r *processStepsOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.ProcessStepsOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Deleted(ctx, schemas.ProcessStepsOrderResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts a project ID or slug, and imports the order of every step of its deployment process, referencing
+// the steps by slug. The steps of version-controlled projects are imported from the default branch.
+func (r *processStepsOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	project, err := projects.GetByID(r.Client, r.Client.GetSpaceID(), req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to find project %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), project.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), project.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), project.SpaceID)...)
+}
+
+func (r *processStepsOrderResource) orderSteps(model schemas.ProcessStepsOrderResourceModel) error {
+	_, err := updateDeploymentProcess(r.Client, model.SpaceID.ValueString(), model.ProjectID.ValueString(), model.Branch.ValueString(), func(process *deployments.DeploymentProcess) error {
+		steps, err := orderDeploymentSteps(process.Steps, util.ExpandStringList(model.Steps))
+		if err != nil {
+			return err
+		}
+		process.Steps = steps
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,238 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.ResourceWithImportState = &releaseResource{}
+
+type releaseResource struct {
+	*Config
+}
+
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+func (*releaseResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ReleaseResourceDescription)
+}
+
+func (*releaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ReleaseSchema{}.GetResourceSchema()
+}
+
+func (r *releaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+func (r *releaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.ReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, diags := expandCreateReleaseCommand(ctx, plan, r.Client.GetSpaceID())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.ReleaseResourceDescription, plan)
+
+	created, err := releases.CreateReleaseV1(r.Client, command)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create release", err.Error())
+		return
+	}
+
+	release, err := newclient.GetByID[releases.Release](r.Client, uritemplates.Releases, command.SpaceID, created.ReleaseID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load release %s", created.ReleaseID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setRelease(ctx, &plan, release)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	util.Created(ctx, schemas.ReleaseResourceDescription, release)
+}
+
+func (r *releaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.ReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.ReleaseResourceDescription, state)
+
+	release, err := newclient.GetByID[releases.Release](r.Client, uritemplates.Releases, releaseSpaceID(state, r.Client.GetSpaceID()), state.ID.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.ReleaseResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load release", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(setRelease(ctx, &state, release)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Read(ctx, schemas.ReleaseResourceDescription, release)
+}
+
+// Update only records the plan: every attribute that describes the release requires it to be replaced, except when
+// the configuration is first adopted after an import.
+func (r *releaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.ReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, schemas.ReleaseImportedPrivateKey, nil)...)
+}
+
+func (r *releaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.ReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.ReleaseResourceDescription, state)
+
+	if err := newclient.DeleteByID(r.Client, uritemplates.Releases, releaseSpaceID(state, r.Client.GetSpaceID()), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("unable to delete release", err.Error())
+		return
+	}
+
+	util.Deleted(ctx, schemas.ReleaseResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState accepts a release ID, or a project slug and release version separated by a colon, such as
+// my-project:1.0.0. The release is marked as imported, so the attributes that cannot be read back from it are taken
+// from the configuration on the next apply rather than replacing it.
+func (r *releaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectSlug, version, found := strings.Cut(req.ID, ":")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, schemas.ReleaseImportedPrivateKey, []byte("true"))...)
+		return
+	}
+
+	if projectSlug == "" || version == "" {
+		resp.Diagnostics.AddError(
+			"Incorrect Import Format",
+			"ID must be a release ID, or in the format: ProjectSlug:Version (e.g. my-project:1.0.0)",
+		)
+		return
+	}
+
+	spaceID := r.Client.GetSpaceID()
+	project, err := projects.GetByID(r.Client, spaceID, projectSlug)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to find project %s", projectSlug), err.Error())
+		return
+	}
+
+	release, err := releases.GetReleaseInProject(r.Client, spaceID, project.GetID(), version)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to find release %s of project %s", version, projectSlug), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), release.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), release.SpaceID)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, schemas.ReleaseImportedPrivateKey, []byte("true"))...)
+}
+
+func expandCreateReleaseCommand(ctx context.Context, model schemas.ReleaseResourceModel, defaultSpaceID string) (*releases.CreateReleaseCommandV1, diag.Diagnostics) {
+	command := releases.NewCreateReleaseCommandV1(releaseSpaceID(model, defaultSpaceID), model.ProjectID.ValueString())
+	command.ChannelIDOrName = model.ChannelID.ValueString()
+	command.ReleaseVersion = model.Version.ValueString()
+	command.PackageVersion = model.DefaultPackageVersion.ValueString()
+	command.ReleaseNotes = model.ReleaseNotes.ValueString()
+	command.GitRef = model.GitRef.ValueString()
+	command.GitCommit = model.GitCommit.ValueString()
+	command.IgnoreChannelRules = model.IgnoreChannelRules.ValueBool()
+
+	var packages []schemas.ReleasePackageModel
+	diags := model.Packages.ElementsAs(ctx, &packages, false)
+	for _, p := range packages {
+		command.Packages = append(command.Packages, formatReleasePackage(p))
+	}
+
+	return command, diags
+}
+
+// formatReleasePackage formats a package version in the form the Octopus CLI accepts: StepName:Version, or
+// StepName:PackageReferenceName:Version.
+func formatReleasePackage(p schemas.ReleasePackageModel) string {
+	if p.PackageReferenceName.ValueString() == "" {
+		return fmt.Sprintf("%s:%s", p.StepName.ValueString(), p.Version.ValueString())
+	}
+	return fmt.Sprintf("%s:%s:%s", p.StepName.ValueString(), p.PackageReferenceName.ValueString(), p.Version.ValueString())
+}
+
+func releaseSpaceID(model schemas.ReleaseResourceModel, defaultSpaceID string) string {
+	if spaceID := model.SpaceID.ValueString(); spaceID != "" {
+		return spaceID
+	}
+	return defaultSpaceID
+}
+
+func setRelease(ctx context.Context, model *schemas.ReleaseResourceModel, release *releases.Release) diag.Diagnostics {
+	model.ID = types.StringValue(release.GetID())
+	model.SpaceID = types.StringValue(release.SpaceID)
+	model.ProjectID = types.StringValue(release.ProjectID)
+	model.ChannelID = types.StringValue(release.ChannelID)
+	model.Version = types.StringValue(release.Version)
+	model.ReleaseNotes = types.StringValue(release.ReleaseNotes)
+	model.IgnoreChannelRules = types.BoolValue(release.IgnoreChannelRules)
+
+	selectedPackages, diags := flattenSelectedPackages(ctx, release.SelectedPackages)
+	model.SelectedPackages = selectedPackages
+	return diags
+}
+
+func flattenSelectedPackages(ctx context.Context, selectedPackages []*packages.SelectedPackage) (types.List, diag.Diagnostics) {
+	selected := make([]schemas.ReleaseSelectedPackageModel, 0, len(selectedPackages))
+	for _, p := range selectedPackages {
+		selected = append(selected, schemas.ReleaseSelectedPackageModel{
+			StepName:             types.StringValue(p.StepName),
+			ActionName:           types.StringValue(p.ActionName),
+			PackageReferenceName: types.StringValue(p.PackageReferenceName),
+			Version:              types.StringValue(p.Version),
+		})
+	}
+
+	return types.ListValueFrom(ctx, basetypes.ObjectType{AttrTypes: schemas.ReleaseSelectedPackageObjectType()}, selected)
+}
@@ -0,0 +1,147 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandCreateReleaseCommand(t *testing.T) {
+	packageType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"step_name":              types.StringType,
+		"package_reference_name": types.StringType,
+		"version":                types.StringType,
+	}}
+	packages, diags := types.ListValueFrom(context.Background(), packageType, []schemas.ReleasePackageModel{
+		{StepName: types.StringValue("Deploy Web"), PackageReferenceName: types.StringNull(), Version: types.StringValue("1.2.3")},
+		{StepName: types.StringValue("Run Script"), PackageReferenceName: types.StringValue("tools"), Version: types.StringValue("2.0.0")},
+	})
+	require.False(t, diags.HasError())
+
+	model := schemas.ReleaseResourceModel{
+		ProjectID:             types.StringValue("Projects-1"),
+		ChannelID:             types.StringUnknown(),
+		Version:               types.StringValue("1.0.0"),
+		DefaultPackageVersion: types.StringValue("1.0.0"),
+		Packages:              packages,
+		ReleaseNotes:          types.StringUnknown(),
+		GitRef:                types.StringValue("refs/heads/main"),
+		IgnoreChannelRules:    types.BoolValue(true),
+	}
+
+	command, diags := expandCreateReleaseCommand(context.Background(), model, "Spaces-1")
+	require.False(t, diags.HasError())
+	require.Equal(t, "Spaces-1", command.SpaceID)
+	require.Equal(t, "Projects-1", command.ProjectIDOrName)
+	require.Empty(t, command.ChannelIDOrName)
+	require.Empty(t, command.ReleaseNotes)
+	require.Equal(t, "1.0.0", command.ReleaseVersion)
+	require.Equal(t, "refs/heads/main", command.GitRef)
+	require.True(t, command.IgnoreChannelRules)
+	require.Equal(t, []string{"Deploy Web:1.2.3", "Run Script:tools:2.0.0"}, command.Packages)
+
+	model.SpaceID = types.StringValue("Spaces-2")
+	command, _ = expandCreateReleaseCommand(context.Background(), model, "Spaces-1")
+	require.Equal(t, "Spaces-2", command.SpaceID)
+}
+
+func TestReleaseImportRejectsIncompleteIdentifier(t *testing.T) {
+	s := schemas.ReleaseSchema{}.GetResourceSchema()
+	objectType := s.Type().TerraformType(context.Background())
+
+	for _, id := range []string{":1.0.0", "my-project:"} {
+		resp := resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)}}
+		NewReleaseResource().(*releaseResource).ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+
+		require.True(t, resp.Diagnostics.HasError(), id)
+		require.Equal(t, "Incorrect Import Format", resp.Diagnostics[0].Summary(), id)
+	}
+}
+
+func TestReleaseImportThenPlanAdoptsConfiguration(t *testing.T) {
+	ctx := context.Background()
+	objectType := schemas.ReleaseSchema{}.GetResourceSchema().Type().TerraformType(ctx).(tftypes.Object)
+	packageType := objectType.AttributeTypes["packages"].(tftypes.List).ElementType.(tftypes.Object)
+	selectedPackageType := objectType.AttributeTypes["selected_packages"].(tftypes.List).ElementType
+
+	release := func(values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		for name, value := range values {
+			attributes[name] = value
+		}
+		value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+		require.NoError(t, err)
+		return &value
+	}
+
+	// The state once the imported release has been read, which cannot record how the release was created.
+	read := map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "Releases-1"),
+		"space_id":             tftypes.NewValue(tftypes.String, "Spaces-1"),
+		"project_id":           tftypes.NewValue(tftypes.String, "Projects-1"),
+		"channel_id":           tftypes.NewValue(tftypes.String, "Channels-1"),
+		"version":              tftypes.NewValue(tftypes.String, "1.0.0"),
+		"release_notes":        tftypes.NewValue(tftypes.String, ""),
+		"ignore_channel_rules": tftypes.NewValue(tftypes.Bool, false),
+		"selected_packages":    tftypes.NewValue(tftypes.List{ElementType: selectedPackageType}, []tftypes.Value{}),
+	}
+	configured := map[string]tftypes.Value{
+		"project_id":              tftypes.NewValue(tftypes.String, "Projects-1"),
+		"version":                 tftypes.NewValue(tftypes.String, "1.0.0"),
+		"default_package_version": tftypes.NewValue(tftypes.String, "1.2.3"),
+		"git_ref":                 tftypes.NewValue(tftypes.String, "refs/heads/main"),
+		"packages": tftypes.NewValue(tftypes.List{ElementType: packageType}, []tftypes.Value{
+			tftypes.NewValue(packageType, map[string]tftypes.Value{
+				"step_name":              tftypes.NewValue(tftypes.String, "Deploy Web"),
+				"package_reference_name": tftypes.NewValue(tftypes.String, nil),
+				"version":                tftypes.NewValue(tftypes.String, "1.2.4"),
+			}),
+		}),
+	}
+	proposed := map[string]tftypes.Value{}
+	for name, value := range read {
+		proposed[name] = value
+	}
+	for name, value := range configured {
+		proposed[name] = value
+	}
+
+	server := providerserver.NewProtocol6(NewOctopusDeployFrameworkProvider())()
+	imported, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{TypeName: "octopusdeploy_release", ID: "Releases-1"})
+	require.NoError(t, err)
+	require.Empty(t, imported.Diagnostics)
+	require.Len(t, imported.ImportedResources, 1)
+
+	plan := func(private []byte) *tfprotov6.PlanResourceChangeResponse {
+		resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "octopusdeploy_release",
+			PriorState:       release(read),
+			ProposedNewState: release(proposed),
+			Config:           release(configured),
+			PriorPrivate:     private,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Diagnostics)
+		return resp
+	}
+
+	require.Empty(t, plan(imported.ImportedResources[0].Private).RequiresReplace)
+
+	require.ElementsMatch(t, []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("default_package_version"),
+		tftypes.NewAttributePath().WithAttributeName("git_ref"),
+		tftypes.NewAttributePath().WithAttributeName("packages"),
+	}, plan(nil).RequiresReplace)
+}
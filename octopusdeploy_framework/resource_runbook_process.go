@@ -0,0 +1,257 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbookprocess"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ResourceWithUpgradeState = &runbookProcessResource{}
+
+type runbookProcessResource struct {
+	*Config
+}
+
+func NewRunbookProcessResource() resource.Resource {
+	return &runbookProcessResource{}
+}
+
+func (*runbookProcessResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.RunbookProcessResourceDescription)
+}
+
+func (*runbookProcessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.RunbookProcessSchema{}.GetResourceSchema()
+}
+
+func (r *runbookProcessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create replaces the steps of the process every runbook already has.
+func (r *runbookProcessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.RunbookProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.RunbookProcessResourceDescription, plan)
+
+	spaceID := processSpaceID(plan.SpaceID, r.Client.GetSpaceID())
+	runbooksAreInGit, err := internal.CheckRunbookInGit(r.Client, spaceID, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify Projects Runbooks persistence settings", err.Error())
+		return
+	}
+
+	if runbooksAreInGit {
+		resp.Diagnostics.AddWarning("Unable to manage CaC Runbooks via Terraform", "Runbook process is in git, skipping create")
+		resp.Diagnostics.Append(setPlannedRunbookProcess(req.Plan, &resp.State)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.RunbookID)...)
+		return
+	}
+
+	runbook, err := runbooks.GetByID(r.Client, spaceID, plan.RunbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load runbook", err.Error())
+		return
+	}
+
+	current, err := runbookprocess.GetByID(r.Client, spaceID, runbook.RunbookProcessID)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load runbook process", err.Error())
+		return
+	}
+
+	current.ProjectID = runbook.ProjectID
+	process, err := saveRunbookProcess(ctx, r.Client, current, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create runbook process", err.Error())
+		return
+	}
+
+	state := flattenRunbookProcess(process, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Created(ctx, schemas.RunbookProcessResourceDescription, state)
+}
+
+func (r *runbookProcessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.RunbookProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.RunbookProcessResourceDescription, state)
+
+	spaceID := processSpaceID(state.SpaceID, r.Client.GetSpaceID())
+	runbooksAreInGit, err := internal.CheckRunbookInGit(r.Client, spaceID, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify Projects Runbooks persistence settings", err.Error())
+		return
+	}
+
+	if runbooksAreInGit {
+		resp.Diagnostics.AddWarning("Unable to manage CaC Runbooks via Terraform", "Runbook process is in git, skipping read")
+		return
+	}
+
+	process, err := runbookprocess.GetByID(r.Client, spaceID, state.ID.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.RunbookProcessResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load runbook process", err.Error())
+		}
+		return
+	}
+
+	newState := flattenRunbookProcess(process, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Read(ctx, schemas.RunbookProcessResourceDescription, newState)
+}
+
+func (r *runbookProcessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.RunbookProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.RunbookProcessResourceDescription, plan)
+
+	spaceID := processSpaceID(plan.SpaceID, r.Client.GetSpaceID())
+	runbooksAreInGit, err := internal.CheckRunbookInGit(r.Client, spaceID, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify Projects Runbooks persistence settings", err.Error())
+		return
+	}
+
+	if runbooksAreInGit {
+		resp.Diagnostics.AddWarning("Unable to manage CaC Runbooks via Terraform", "Runbook process is in git, skipping update")
+		resp.Diagnostics.Append(setPlannedRunbookProcess(req.Plan, &resp.State)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), state.ID)...)
+		return
+	}
+
+	current, err := runbookprocess.GetByID(r.Client, spaceID, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load runbook process", err.Error())
+		return
+	}
+
+	process, err := saveRunbookProcess(ctx, r.Client, current, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to update runbook process", err.Error())
+		return
+	}
+
+	newState := flattenRunbookProcess(process, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+
+	util.Updated(ctx, schemas.RunbookProcessResourceDescription, newState)
+}
+
+// Delete removes the steps of the runbook process, as the process itself belongs to the runbook.
+func (r *runbookProcessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.RunbookProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.RunbookProcessResourceDescription, state)
+
+	spaceID := processSpaceID(state.SpaceID, r.Client.GetSpaceID())
+	runbooksAreInGit, err := internal.CheckRunbookInGit(r.Client, spaceID, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify Projects Runbooks persistence settings", err.Error())
+		return
+	}
+
+	if runbooksAreInGit {
+		resp.Diagnostics.AddWarning("Unable to manage CaC Runbooks via Terraform", "Runbook process is in git, skipping delete")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := runbookprocess.GetByID(r.Client, spaceID, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load runbook process", err.Error())
+		return
+	}
+
+	current.Steps = []*deployments.DeploymentStep{}
+	if _, err := runbookprocess.Update(r.Client, current); err != nil {
+		resp.Diagnostics.AddError("unable to delete runbook process", err.Error())
+		return
+	}
+
+	util.Deleted(ctx, schemas.RunbookProcessResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+func (*runbookProcessResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeProcessStateFromSDK},
+	}
+}
+
+// saveRunbookProcess replaces the steps of a runbook process with the planned steps.
+func saveRunbookProcess(ctx context.Context, client newclient.Client, current *runbookprocess.RunbookProcess, plan schemas.RunbookProcessResourceModel) (*runbookprocess.RunbookProcess, error) {
+	if plan.LastSnapshotID.ValueString() != "" {
+		current.LastSnapshotID = plan.LastSnapshotID.ValueString()
+	}
+	current.Steps = expandDeploymentSteps(ctx, plan.Steps, current.Steps)
+	return runbookprocess.Update(client, current)
+}
+
+func flattenRunbookProcess(process *runbookprocess.RunbookProcess, prior schemas.RunbookProcessResourceModel) schemas.RunbookProcessResourceModel {
+	state := schemas.RunbookProcessResourceModel{
+		LastSnapshotID: types.StringValue(process.LastSnapshotID),
+		ProjectID:      types.StringValue(process.ProjectID),
+		RunbookID:      types.StringValue(process.RunbookID),
+		SpaceID:        types.StringValue(process.SpaceID),
+		Steps:          flattenDeploymentSteps(process.Steps, prior.Steps),
+		Version:        types.Int64Null(),
+	}
+	state.ID = types.StringValue(process.GetID())
+	if process.Version != nil {
+		state.Version = types.Int64Value(int64(*process.Version))
+	}
+	return state
+}
+
+// setPlannedRunbookProcess saves the planned process as the state of a runbook process stored in version control,
+// which Terraform does not change. Values that are only known after apply are saved as null.
+func setPlannedRunbookProcess(plan tfsdk.Plan, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("unable to save runbook process", err.Error())
+		return diags
+	}
+
+	state.Raw = value
+	return diags
+}
@@ -0,0 +1,149 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type runbookRunResource struct {
+	*Config
+}
+
+func NewRunbookRunResource() resource.Resource {
+	return &runbookRunResource{}
+}
+
+func (*runbookRunResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.RunbookRunResourceDescription)
+}
+
+func (*runbookRunResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.RunbookRunSchema{}.GetResourceSchema()
+}
+
+func (r *runbookRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// Create runs the runbook, and records the runs in state before waiting for them, so a run that fails or times out is
+// tainted and run again by the next apply.
+func (r *runbookRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.RunbookRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := parseServerTaskTimeout(plan.Timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "invalid timeout", err.Error())
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = r.Client.GetSpaceID()
+	}
+
+	runbook, err := runbooks.GetByID(r.Client, spaceID, plan.RunbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load runbook %s", plan.RunbookID.ValueString()), err.Error())
+		return
+	}
+
+	command, diags := expandRunbookRunCommand(ctx, plan, spaceID, runbook.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Create(ctx, schemas.RunbookRunResourceDescription, plan)
+
+	response, err := runbooks.RunbookRunV1(r.Client, command)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to run runbook %s", runbook.Name), err.Error())
+		return
+	}
+
+	var runbookRunIDs, taskIDs []string
+	for _, task := range response.RunbookRunServerTasks {
+		runbookRunIDs = append(runbookRunIDs, task.RunbookRunID)
+		taskIDs = append(taskIDs, task.ServerTaskID)
+	}
+	if len(runbookRunIDs) == 0 {
+		resp.Diagnostics.AddError("unable to run runbook", "Octopus Deploy did not create any runbook runs")
+		return
+	}
+
+	plan.ID = types.StringValue(runbookRunIDs[0])
+	plan.SpaceID = types.StringValue(spaceID)
+	plan.RunbookRunIDs = util.FlattenStringList(runbookRunIDs)
+	plan.TaskIDs = util.FlattenStringList(taskIDs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(waitForServerTasks(ctx, r.Client, spaceID, taskIDs, timeout, "runbook run")...)
+	}
+
+	util.Created(ctx, schemas.RunbookRunResourceDescription, plan)
+}
+
+// Read keeps the state as it is: runbook runs are removed by retention policies, and a run that has been cleaned up
+// must not be run again.
+func (r *runbookRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.RunbookRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records the plan: every attribute that describes the run requires it to be replaced, and the remaining
+// attributes only control how Create waits for the run.
+func (r *runbookRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.RunbookRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the runbook run from state only; it stays in the run history of the runbook.
+func (r *runbookRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.RunbookRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Deleted(ctx, schemas.RunbookRunResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+func expandRunbookRunCommand(ctx context.Context, model schemas.RunbookRunResourceModel, spaceID string, projectID string) (*runbooks.RunbookRunCommandV1, diag.Diagnostics) {
+	execution, diags := expandExecutionCommand(ctx, model.ServerTaskExecutionModel, spaceID, projectID)
+
+	command := &runbooks.RunbookRunCommandV1{
+		RunbookName:                      model.RunbookID.ValueString(),
+		Snapshot:                         model.SnapshotID.ValueString(),
+		CreateExecutionAbstractCommandV1: execution,
+	}
+	diags.Append(model.EnvironmentIDs.ElementsAs(ctx, &command.EnvironmentNames, false)...)
+	diags.Append(model.TenantIDs.ElementsAs(ctx, &command.Tenants, false)...)
+	return command, diags
+}
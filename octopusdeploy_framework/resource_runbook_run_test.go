@@ -0,0 +1,44 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRunbookRunCommand(t *testing.T) {
+	model := schemas.RunbookRunResourceModel{
+		RunbookID:      types.StringValue("Runbooks-1"),
+		SnapshotID:     types.StringNull(),
+		EnvironmentIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Environments-1")}),
+		TenantIDs:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Tenants-1")}),
+		ServerTaskExecutionModel: schemas.ServerTaskExecutionModel{
+			GuidedFailureMode:  types.StringValue("EnvironmentDefault"),
+			SkipStepNames:      types.SetNull(types.StringType),
+			SpecificMachineIDs: types.SetNull(types.StringType),
+			ExcludedMachineIDs: types.SetNull(types.StringType),
+			Variables:          types.MapValueMust(types.StringType, map[string]attr.Value{"Database": types.StringValue("orders")}),
+		},
+	}
+
+	command, diags := expandRunbookRunCommand(context.Background(), model, "Spaces-1", "Projects-1")
+	require.False(t, diags.HasError())
+	require.Equal(t, "Runbooks-1", command.RunbookName)
+	require.Empty(t, command.Snapshot)
+	require.Equal(t, []string{"Environments-1"}, command.EnvironmentNames)
+	require.Equal(t, []string{"Tenants-1"}, command.Tenants)
+	require.Equal(t, "Projects-1", command.ProjectIDOrName)
+	require.Nil(t, command.UseGuidedFailure)
+	require.Equal(t, map[string]string{"Database": "orders"}, command.Variables)
+
+	model.SnapshotID = types.StringValue("RunbookSnapshots-1")
+	model.TenantIDs = types.SetNull(types.StringType)
+	command, diags = expandRunbookRunCommand(context.Background(), model, "Spaces-1", "Projects-1")
+	require.False(t, diags.HasError())
+	require.Equal(t, "RunbookSnapshots-1", command.Snapshot)
+	require.Empty(t, command.Tenants)
+}
@@ -0,0 +1,349 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	runbookSnapshotTemplate         = "/api/{spaceId}/runbookSnapshots{/id}{?publish}"
+	runbookSnapshotTemplateTemplate = "/api/{spaceId}/runbooks{/id}/runbookSnapshotTemplate"
+)
+
+var _ resource.ResourceWithModifyPlan = &runbookSnapshotResource{}
+
+type runbookSnapshotResource struct {
+	*Config
+}
+
+func NewRunbookSnapshotResource() resource.Resource {
+	return &runbookSnapshotResource{}
+}
+
+func (*runbookSnapshotResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.RunbookSnapshotResourceDescription)
+}
+
+func (*runbookSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.RunbookSnapshotSchema{}.GetResourceSchema()
+}
+
+func (r *runbookSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(req, resp)
+}
+
+// ModifyPlan replaces the snapshot once the runbook process has changed since it was taken, unless
+// runbook_process_version is set in the configuration.
+func (r *runbookSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.Config == nil {
+		return
+	}
+
+	var configured types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook_process_version"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	var state schemas.RunbookSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := r.getRunbookProcessVersion(state.SpaceID.ValueString(), state.RunbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("unable to check the runbook process version", err.Error())
+		return
+	}
+
+	if version != state.RunbookProcessVersion.ValueInt64() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("runbook_process_version"), types.Int64Value(version))...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("runbook_process_version"))
+	}
+}
+
+func (r *runbookSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.RunbookSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = r.Client.GetSpaceID()
+	}
+
+	runbook, err := runbooks.GetByID(r.Client, spaceID, plan.RunbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load runbook %s", plan.RunbookID.ValueString()), err.Error())
+		return
+	}
+
+	template, err := newclient.GetByID[runbooks.RunbookSnapshotTemplate](r.Client, runbookSnapshotTemplateTemplate, spaceID, runbook.GetID())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load the snapshot template of runbook %s", runbook.Name), err.Error())
+		return
+	}
+
+	var versions []schemas.ReleasePackageModel
+	resp.Diagnostics.Append(plan.Packages.ElementsAs(ctx, &versions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selectedPackages, err := selectSnapshotPackages(template.Packages, versions, plan.DefaultPackageVersion.ValueString(), func(p *releases.ReleaseTemplatePackage) (string, error) {
+		return getLatestPackageVersion(r.Client, spaceID, p)
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("packages"), "unable to select package versions", err.Error())
+		return
+	}
+
+	name := plan.Name.ValueString()
+	if plan.Name.IsUnknown() || name == "" {
+		name = template.NextNameIncrement
+	}
+
+	snapshot := runbooks.NewRunbookSnapshot(name, runbook.ProjectID, runbook.GetID())
+	snapshot.SpaceID = spaceID
+	snapshot.Notes = plan.Notes.ValueString()
+	snapshot.SelectedPackages = selectedPackages
+
+	util.Create(ctx, schemas.RunbookSnapshotResourceDescription, snapshot)
+
+	uri, err := r.Client.URITemplateCache().Expand(runbookSnapshotTemplate, map[string]any{"spaceId": spaceID, "publish": plan.Publish.ValueBool()})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create runbook snapshot", err.Error())
+		return
+	}
+
+	created, err := newclient.Post[runbooks.RunbookSnapshot](r.Client.HttpSession(), uri, snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create runbook snapshot", err.Error())
+		return
+	}
+
+	if plan.RunbookProcessVersion.IsUnknown() {
+		version, err := r.getRunbookProcessVersion(spaceID, runbook.GetID())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to load the runbook process version", err.Error())
+			return
+		}
+		plan.RunbookProcessVersion = types.Int64Value(version)
+	}
+
+	plan.ID = types.StringValue(created.GetID())
+	plan.SpaceID = types.StringValue(spaceID)
+	plan.ProjectID = types.StringValue(created.ProjectID)
+	plan.Name = types.StringValue(created.Name)
+	selected, diags := flattenSelectedPackages(ctx, created.SelectedPackages)
+	resp.Diagnostics.Append(diags...)
+	plan.SelectedPackages = selected
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	util.Created(ctx, schemas.RunbookSnapshotResourceDescription, created)
+}
+
+func (r *runbookSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.RunbookSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Reading(ctx, schemas.RunbookSnapshotResourceDescription, state)
+
+	snapshot, err := newclient.GetByID[runbooks.RunbookSnapshot](r.Client, runbookSnapshotTemplate, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, state, err, schemas.RunbookSnapshotResourceDescription); err != nil {
+			resp.Diagnostics.AddError("unable to load runbook snapshot", err.Error())
+		}
+		return
+	}
+
+	// Only a snapshot that should be published is checked: once another snapshot has been published, publish is recorded
+	// as false so the next apply publishes this snapshot again. Publishing it outside Terraform while publish is false
+	// is left alone.
+	if state.Publish.ValueBool() {
+		runbook, err := runbooks.GetByID(r.Client, state.SpaceID.ValueString(), snapshot.RunbookID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("unable to load runbook %s", snapshot.RunbookID), err.Error())
+			return
+		}
+		state.Publish = types.BoolValue(runbook.PublishedRunbookSnapshotID == snapshot.GetID())
+	}
+
+	state.RunbookID = types.StringValue(snapshot.RunbookID)
+	state.ProjectID = types.StringValue(snapshot.ProjectID)
+	state.Name = types.StringValue(snapshot.Name)
+	selected, diags := flattenSelectedPackages(ctx, snapshot.SelectedPackages)
+	resp.Diagnostics.Append(diags...)
+	state.SelectedPackages = selected
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	util.Read(ctx, schemas.RunbookSnapshotResourceDescription, snapshot)
+}
+
+// Update publishes the snapshot when publish is turned on. Snapshots cannot be unpublished, so turning publish off only
+// records the plan.
+func (r *runbookSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state schemas.RunbookSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Update(ctx, schemas.RunbookSnapshotResourceDescription, plan)
+
+	if plan.Publish.ValueBool() && !state.Publish.ValueBool() {
+		runbook, err := runbooks.GetByID(r.Client, state.SpaceID.ValueString(), state.RunbookID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("unable to load runbook %s", state.RunbookID.ValueString()), err.Error())
+			return
+		}
+
+		runbook.PublishedRunbookSnapshotID = state.ID.ValueString()
+		if _, err := runbooks.Update(r.Client, runbook); err != nil {
+			resp.Diagnostics.AddError("unable to publish runbook snapshot", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	util.Updated(ctx, schemas.RunbookSnapshotResourceDescription, plan)
+}
+
+// Delete deletes the snapshot, unless it is still the published snapshot of the runbook: the runbook is then left with
+// a published snapshot, and the snapshot is removed by the retention policy once it is no longer published.
+func (r *runbookSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.RunbookSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.Delete(ctx, schemas.RunbookSnapshotResourceDescription, state)
+
+	runbook, err := runbooks.GetByID(r.Client, state.SpaceID.ValueString(), state.RunbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to load runbook %s", state.RunbookID.ValueString()), err.Error())
+		return
+	}
+
+	if runbook.PublishedRunbookSnapshotID != state.ID.ValueString() {
+		if err := newclient.DeleteByID(r.Client, runbookSnapshotTemplate, state.SpaceID.ValueString(), state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("unable to delete runbook snapshot", err.Error())
+			return
+		}
+	}
+
+	util.Deleted(ctx, schemas.RunbookSnapshotResourceDescription, state)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *runbookSnapshotResource) getRunbookProcessVersion(spaceID string, runbookID string) (int64, error) {
+	runbook, err := runbooks.GetByID(r.Client, spaceID, runbookID)
+	if err != nil {
+		return 0, err
+	}
+
+	process, err := runbooks.GetProcess(r.Client, spaceID, runbook.ProjectID, runbook.RunbookProcessID)
+	if err != nil {
+		return 0, err
+	}
+
+	if process.Version == nil {
+		return 0, nil
+	}
+	return int64(*process.Version), nil
+}
+
+// selectSnapshotPackages chooses the version of each package in the runbook process: the matching entry of versions,
+// then the fixed version of the step, then defaultVersion, and finally the latest version in the feed.
+func selectSnapshotPackages(templatePackages []*releases.ReleaseTemplatePackage, versions []schemas.ReleasePackageModel, defaultVersion string, latestVersion func(*releases.ReleaseTemplatePackage) (string, error)) ([]*packages.SelectedPackage, error) {
+	matched := make([]bool, len(versions))
+	selected := make([]*packages.SelectedPackage, 0, len(templatePackages))
+
+	for _, p := range templatePackages {
+		version := ""
+		for i, v := range versions {
+			if v.StepName.ValueString() != p.StepName && v.StepName.ValueString() != p.PackageID {
+				continue
+			}
+			if reference := v.PackageReferenceName.ValueString(); reference != "" && reference != p.PackageReferenceName {
+				continue
+			}
+			matched[i] = true
+			version = v.Version.ValueString()
+		}
+
+		if version == "" {
+			version = p.FixedVersion
+		}
+		if version == "" {
+			version = defaultVersion
+		}
+		if version == "" {
+			if !p.IsResolvable {
+				return nil, fmt.Errorf("the version of package %s in step %s cannot be looked up, so it must be set in packages or default_package_version", p.PackageID, p.StepName)
+			}
+
+			latest, err := latestVersion(p)
+			if err != nil {
+				return nil, fmt.Errorf("unable to find the latest version of package %s in step %s: %w", p.PackageID, p.StepName, err)
+			}
+			version = latest
+		}
+
+		selected = append(selected, &packages.SelectedPackage{
+			ActionName:           p.ActionName,
+			PackageReferenceName: p.PackageReferenceName,
+			StepName:             p.StepName,
+			Version:              version,
+		})
+	}
+
+	for i, v := range versions {
+		if !matched[i] {
+			return nil, fmt.Errorf("%s does not match a step or package in the runbook process", v.StepName.ValueString())
+		}
+	}
+
+	return selected, nil
+}
+
+func getLatestPackageVersion(client newclient.Client, spaceID string, p *releases.ReleaseTemplatePackage) (string, error) {
+	versions, err := feeds.SearchPackageVersions(client, spaceID, p.FeedID, p.PackageID, "", 1)
+	if err != nil {
+		return "", err
+	}
+
+	if len(versions.Items) == 0 {
+		return "", fmt.Errorf("feed %s has no versions of the package", p.FeedID)
+	}
+	return versions.Items[0].Version, nil
+}
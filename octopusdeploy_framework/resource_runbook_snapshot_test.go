@@ -0,0 +1,64 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newSnapshotTemplatePackages() []*releases.ReleaseTemplatePackage {
+	return []*releases.ReleaseTemplatePackage{
+		{StepName: "Deploy Web", ActionName: "Deploy Web", PackageID: "web", FeedID: "Feeds-1", IsResolvable: true},
+		{StepName: "Migrate", ActionName: "Migrate", PackageID: "migrator", PackageReferenceName: "tools", FeedID: "Feeds-1", IsResolvable: true},
+		{StepName: "Migrate", ActionName: "Migrate", PackageID: "scripts", PackageReferenceName: "scripts", FeedID: "Feeds-1", IsResolvable: true},
+	}
+}
+
+func latestPackageVersion(p *releases.ReleaseTemplatePackage) (string, error) {
+	return "9.9.9-" + p.PackageID, nil
+}
+
+func TestSelectSnapshotPackagesPrefersExplicitVersions(t *testing.T) {
+	versions := []schemas.ReleasePackageModel{
+		{StepName: types.StringValue("web"), PackageReferenceName: types.StringNull(), Version: types.StringValue("1.0.0")},
+		{StepName: types.StringValue("Migrate"), PackageReferenceName: types.StringValue("tools"), Version: types.StringValue("2.0.0")},
+	}
+
+	selected, err := selectSnapshotPackages(newSnapshotTemplatePackages(), versions, "", latestPackageVersion)
+	require.NoError(t, err)
+	require.Len(t, selected, 3)
+	require.Equal(t, "1.0.0", selected[0].Version)
+	require.Equal(t, "2.0.0", selected[1].Version)
+	require.Equal(t, "tools", selected[1].PackageReferenceName)
+	require.Equal(t, "9.9.9-scripts", selected[2].Version)
+}
+
+func TestSelectSnapshotPackagesUsesDefaultVersion(t *testing.T) {
+	templatePackages := newSnapshotTemplatePackages()
+	templatePackages[0].FixedVersion = "0.1.0"
+
+	selected, err := selectSnapshotPackages(templatePackages, nil, "3.0.0", func(*releases.ReleaseTemplatePackage) (string, error) {
+		return "", fmt.Errorf("the feed should not be searched")
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0.1.0", selected[0].Version)
+	require.Equal(t, "3.0.0", selected[1].Version)
+	require.Equal(t, "3.0.0", selected[2].Version)
+}
+
+func TestSelectSnapshotPackagesRejectsUnmatchedAndUnresolvablePackages(t *testing.T) {
+	versions := []schemas.ReleasePackageModel{
+		{StepName: types.StringValue("Missing Step"), PackageReferenceName: types.StringNull(), Version: types.StringValue("1.0.0")},
+	}
+	_, err := selectSnapshotPackages(newSnapshotTemplatePackages(), versions, "1.0.0", latestPackageVersion)
+	require.ErrorContains(t, err, "Missing Step does not match")
+
+	templatePackages := newSnapshotTemplatePackages()
+	templatePackages[0].IsResolvable = false
+	_, err = selectSnapshotPackages(templatePackages, nil, "", latestPackageVersion)
+	require.ErrorContains(t, err, "cannot be looked up")
+}
@@ -31,6 +31,7 @@ type tenantCommonVariableResourceModel struct {
 	Value                types.String `tfsdk:"value"`
 
 	schemas.ResourceModel
+	schemas.ValueWriteOnlyModel
 }
 
 func NewTenantCommonVariableResource() resource.Resource {
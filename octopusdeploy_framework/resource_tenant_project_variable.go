@@ -33,6 +33,7 @@ type tenantProjectVariableResourceModel struct {
 	Value         types.String `tfsdk:"value"`
 
 	schemas.ResourceModel
+	schemas.ValueWriteOnlyModel
 }
 
 func NewTenantProjectVariableResource() resource.Resource {
@@ -2,6 +2,8 @@ package octopusdeploy_framework
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
@@ -9,6 +11,8 @@ import (
 	"crypto/x509/pkix"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
+	"fmt"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
 	"software.sslmate.com/src/go-pkcs12"
 	"strings"
@@ -16,14 +20,27 @@ import (
 
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultTentacleCertificateValidity is how long a generated certificate is valid for when no rotation is configured.
+const defaultTentacleCertificateValidity = 100 * 365 * 24 * time.Hour
+
 type tentacleCertificateResource struct {
 	*Config
 }
 
+// tentacleCertificate is a certificate for a Tentacle, with its private key, as a passwordless pfx file.
+type tentacleCertificate struct {
+	Base64     string
+	Thumbprint string
+	Expires    time.Time
+}
+
+var _ resource.ResourceWithModifyPlan = &tentacleCertificateResource{}
+
 func NewTentacleCertificateResource() resource.Resource {
 	return &tentacleCertificateResource{}
 }
@@ -47,15 +64,32 @@ func (t *tentacleCertificateResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	certificate, thumbprint, err := generateCertificate("Octopus Tentacle")
+	var certificate *tentacleCertificate
+	if !plan.CertificateData.IsNull() {
+		imported, err := importCertificate(plan.CertificateData.ValueString(), plan.Password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("certificate_data"), "cannot import tentacle certificate", err.Error())
+			return
+		}
+		certificate = imported
+	} else {
+		validity, err := parseCertificateValidity(plan.RotateAfter)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "invalid rotate_after", err.Error())
+			return
+		}
 
-	if err != nil {
-		resp.Diagnostics.AddError("cannot generate tentacle", err.Error())
-		return
+		generated, err := generateCertificate("Octopus Tentacle", validity)
+		if err != nil {
+			resp.Diagnostics.AddError("cannot generate tentacle", err.Error())
+			return
+		}
+		certificate = generated
 	}
 
-	plan.Base64 = types.StringValue(certificate)
-	plan.Thumbprint = types.StringValue(thumbprint)
+	plan.Base64 = types.StringValue(certificate.Base64)
+	plan.Thumbprint = types.StringValue(certificate.Thumbprint)
+	plan.Expires = types.StringValue(certificate.Expires.UTC().Format(time.RFC3339))
 	plan.ID = types.StringValue(internal.GenerateRandomCryptoString(20))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -82,14 +116,57 @@ func (r *tentacleCertificateResource) Update(ctx context.Context, req resource.U
 	return
 }
 
-func generateCertificate(fullName string) (string, string, error) {
+// ModifyPlan replaces a generated certificate that is due for rotation once it has expired.
+func (t *tentacleCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan schemas.TentacleCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotateAfter.IsNull() || state.Expires.ValueString() == "" {
+		return
+	}
+
+	expires, err := time.Parse(time.RFC3339, state.Expires.ValueString())
+	if err != nil || time.Now().Before(expires) {
+		return
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.Base64 = types.StringUnknown()
+	plan.Thumbprint = types.StringUnknown()
+	plan.Expires = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotate_after"))
+}
+
+func parseCertificateValidity(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultTentacleCertificateValidity, nil
+	}
+
+	validity, err := time.ParseDuration(value.ValueString())
+	if err != nil || validity <= 0 {
+		return 0, fmt.Errorf("%q is not a positive duration such as 720h or 8760h", value.ValueString())
+	}
+	return validity, nil
+}
+
+func generateCertificate(fullName string, validity time.Duration) (*tentacleCertificate, error) {
 	random := rand.Reader
 
 	privateKey, err := rsa.GenerateKey(random, 2048)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
+	now := time.Now()
 	serialNumber := internal.GenerateRandomSerialNumber()
 	template := x509.Certificate{
 		SerialNumber: &serialNumber,
@@ -99,8 +176,8 @@ func generateCertificate(fullName string) (string, string, error) {
 		Issuer: pkix.Name{
 			CommonName: fullName,
 		},
-		NotBefore: time.Now().AddDate(0, 0, -1),
-		NotAfter:  time.Now().AddDate(100, 0, 0),
+		NotBefore: now.AddDate(0, 0, -1),
+		NotAfter:  now.Add(validity),
 		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage: []x509.ExtKeyUsage{
 			x509.ExtKeyUsageServerAuth,
@@ -111,19 +188,125 @@ func generateCertificate(fullName string) (string, string, error) {
 
 	certBytes, err := x509.CreateCertificate(random, &template, &template, &privateKey.PublicKey, privateKey)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
-	parsedCert, _ := x509.ParseCertificate(certBytes)
-	pkcs12Bytes, err := pkcs12.Passwordless.Encode(privateKey, parsedCert, nil, "")
+	parsedCert, err := x509.ParseCertificate(certBytes)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+
+	return encodeCertificate(privateKey, parsedCert, nil)
+}
+
+// importCertificate reads an existing certificate and its private key from a base64 encoded pfx file, or from PEM
+// blocks, decrypting them with password.
+func importCertificate(data string, password string) (*tentacleCertificate, error) {
+	if strings.Contains(data, "-----BEGIN") {
+		return importPemCertificate([]byte(data), password)
 	}
 
-	pkcs12Base64 := base64.StdEncoding.EncodeToString(pkcs12Bytes)
+	pfx, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, fmt.Errorf("the certificate is neither PEM encoded nor a base64 encoded pfx file: %w", err)
+	}
+
+	privateKey, certificate, caCertificates, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the pfx file: %w", err)
+	}
+
+	return encodeCertificate(privateKey, certificate, caCertificates)
+}
+
+func importPemCertificate(data []byte, password string) (*tentacleCertificate, error) {
+	var certificates []*x509.Certificate
+	var privateKey crypto.PrivateKey
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read the certificate: %w", err)
+			}
+			certificates = append(certificates, certificate)
+
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			key, err := parsePemPrivateKey(block, password)
+			if err != nil {
+				return nil, err
+			}
+			privateKey = key
+		}
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("the PEM data does not contain a certificate")
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("the PEM data does not contain a private key")
+	}
+
+	publicKey, ok := privateKey.(interface{ Public() crypto.PublicKey })
+	if !ok {
+		return nil, fmt.Errorf("the private key type %T is not supported", privateKey)
+	}
+	if matches, ok := publicKey.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !matches.Equal(certificates[0].PublicKey) {
+		return nil, fmt.Errorf("the private key does not belong to the first certificate")
+	}
+
+	return encodeCertificate(privateKey, certificates[0], certificates[1:])
+}
+
+func parsePemPrivateKey(block *pem.Block, password string) (crypto.PrivateKey, error) {
+	keyBytes := block.Bytes
+	if block.Type == "ENCRYPTED PRIVATE KEY" {
+		return nil, fmt.Errorf("encrypted PKCS #8 private keys are not supported; provide a pfx file instead")
+	}
+
+	// Encrypted PEM blocks are insecure and deprecated, but the traditional OpenSSL key format still produces them.
+	if x509.IsEncryptedPEMBlock(block) {
+		if password == "" {
+			return nil, fmt.Errorf("the private key is encrypted, but no password was given")
+		}
+
+		decrypted, err := x509.DecryptPEMBlock(block, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("cannot decrypt the private key: %w", err)
+		}
+		keyBytes = decrypted
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(keyBytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(keyBytes)
+	default:
+		key, err := x509.ParsePKCS8PrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the private key: %w", err)
+		}
+		switch key.(type) {
+		case *rsa.PrivateKey, *ecdsa.PrivateKey:
+			return key, nil
+		}
+		return nil, fmt.Errorf("the private key type %T is not supported", key)
+	}
+}
+
+func encodeCertificate(privateKey crypto.PrivateKey, certificate *x509.Certificate, caCertificates []*x509.Certificate) (*tentacleCertificate, error) {
+	pkcs12Bytes, err := pkcs12.Passwordless.Encode(privateKey, certificate, caCertificates, "")
+	if err != nil {
+		return nil, err
+	}
 
-	thumbprint := sha1.Sum(certBytes)
-	thumbprintStr := strings.ToUpper(hex.EncodeToString(thumbprint[:]))
+	thumbprint := sha1.Sum(certificate.Raw)
 
-	return pkcs12Base64, thumbprintStr, nil
+	return &tentacleCertificate{
+		Base64:     base64.StdEncoding.EncodeToString(pkcs12Bytes),
+		Thumbprint: strings.ToUpper(hex.EncodeToString(thumbprint[:])),
+		Expires:    certificate.NotAfter,
+	}, nil
 }
@@ -0,0 +1,150 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func decodeTentacleCertificate(t *testing.T, certificate *tentacleCertificate) (interface{}, *x509.Certificate) {
+	pfx, err := base64.StdEncoding.DecodeString(certificate.Base64)
+	require.NoError(t, err)
+
+	privateKey, parsed, err := pkcs12.Decode(pfx, "")
+	require.NoError(t, err)
+	return privateKey, parsed
+}
+
+func TestGenerateCertificateHonoursValidity(t *testing.T) {
+	certificate, err := generateCertificate("Octopus Tentacle", 24*time.Hour)
+	require.NoError(t, err)
+
+	_, parsed := decodeTentacleCertificate(t, certificate)
+	require.Equal(t, "Octopus Tentacle", parsed.Subject.CommonName)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), parsed.NotAfter, time.Minute)
+	require.Equal(t, parsed.NotAfter, certificate.Expires)
+	require.Len(t, certificate.Thumbprint, 40)
+}
+
+func TestImportCertificateFromPfx(t *testing.T) {
+	generated, err := generateCertificate("Octopus Tentacle", time.Hour)
+	require.NoError(t, err)
+	privateKey, parsed := decodeTentacleCertificate(t, generated)
+
+	pfx, err := pkcs12.Modern.Encode(privateKey, parsed, nil, "secret")
+	require.NoError(t, err)
+	encoded := base64.StdEncoding.EncodeToString(pfx)
+
+	imported, err := importCertificate(encoded, "secret")
+	require.NoError(t, err)
+	require.Equal(t, generated.Thumbprint, imported.Thumbprint)
+
+	_, err = importCertificate(encoded, "wrong")
+	require.Error(t, err)
+
+	_, err = importCertificate("not a certificate", "")
+	require.Error(t, err)
+}
+
+func TestImportCertificateFromPem(t *testing.T) {
+	generated, err := generateCertificate("Octopus Tentacle", time.Hour)
+	require.NoError(t, err)
+	privateKey, parsed := decodeTentacleCertificate(t, generated)
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: parsed.Raw})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+
+	imported, err := importCertificate(string(certificatePem)+string(keyPem), "")
+	require.NoError(t, err)
+	require.Equal(t, generated.Thumbprint, imported.Thumbprint)
+
+	_, err = importCertificate(string(certificatePem), "")
+	require.ErrorContains(t, err, "does not contain a private key")
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherKey)})
+	_, err = importCertificate(string(certificatePem)+string(otherKeyPem), "")
+	require.ErrorContains(t, err, "does not belong")
+}
+
+func newTentacleCertificatePlan(t *testing.T, rotateAfter string, expires time.Time) resource.ModifyPlanRequest {
+	s := schemas.GetTentacleCertificateSchema()
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "ABC")
+	values["base64"] = tftypes.NewValue(tftypes.String, "pfx")
+	values["thumbprint"] = tftypes.NewValue(tftypes.String, "THUMBPRINT")
+	values["expires"] = tftypes.NewValue(tftypes.String, expires.UTC().Format(time.RFC3339))
+	values["rotate_after"] = tftypes.NewValue(tftypes.String, rotateAfter)
+
+	raw := tftypes.NewValue(objectType, values)
+	return resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: raw},
+		Plan:  tfsdk.Plan{Schema: s, Raw: raw},
+	}
+}
+
+func TestTentacleCertificateRotatesOnceExpired(t *testing.T) {
+	req := newTentacleCertificatePlan(t, "24h", time.Now().Add(-time.Minute))
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	NewTentacleCertificateResource().(*tentacleCertificateResource).ModifyPlan(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.Len(t, resp.RequiresReplace, 1)
+
+	var plan schemas.TentacleCertificateResourceModel
+	resp.Plan.Get(context.Background(), &plan)
+	require.True(t, plan.Thumbprint.IsUnknown())
+}
+
+func TestTentacleCertificateKeptUntilExpired(t *testing.T) {
+	req := newTentacleCertificatePlan(t, "24h", time.Now().Add(time.Hour))
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	NewTentacleCertificateResource().(*tentacleCertificateResource).ModifyPlan(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.Empty(t, resp.RequiresReplace)
+}
+
+func TestTentacleCertificateEphemeralResourceGeneratesCertificate(t *testing.T) {
+	s := schemas.GetTentacleCertificateEphemeralResourceSchema()
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["valid_for"] = tftypes.NewValue(tftypes.String, "720h")
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, values)}}
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: s, Raw: tftypes.NewValue(objectType, nil)}}
+	NewTentacleCertificateEphemeralResource().Open(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var data schemas.TentacleCertificateEphemeralModel
+	resp.Result.Get(context.Background(), &data)
+	require.Len(t, data.Thumbprint.ValueString(), 40)
+	require.NotEmpty(t, data.Base64.ValueString())
+	require.Equal(t, types.StringValue("720h"), data.ValidFor)
+}
@@ -0,0 +1,278 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/spaceresolver"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithConfigure = &resourceWrapper{}
+var _ resource.ResourceWithImportState = &resourceWrapper{}
+var _ resource.ResourceWithModifyPlan = &resourceWrapper{}
+var _ resource.ResourceWithUpgradeState = &resourceWrapper{}
+var _ resource.ResourceWithMoveState = &resourceWrapper{}
+var _ resource.ResourceWithConfigValidators = &resourceWrapper{}
+var _ resource.ResourceWithValidateConfig = &resourceWrapper{}
+
+// resourceWrapper applies provider-wide behaviour to every resource, then delegates to the wrapped resource.
+// It accepts a space name or slug in the space_id attribute: the reference is resolved to a space ID before the
+// wrapped resource sees it, and written back to state afterwards so the configuration does not drift. When the
+// provider is read only, it refuses to create, update or delete the resource, and when planned, it checks the resource
+// against the version matrix. Secrets configured through their write-only variants, such as password_wo, are handed to
+// the wrapped resource in place of the secret and kept out of state.
+type resourceWrapper struct {
+	resource.Resource
+	config *Config
+}
+
+func wrapResources(constructors []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(constructors))
+	for _, constructor := range constructors {
+		wrapped = append(wrapped, func() resource.Resource {
+			return &resourceWrapper{Resource: constructor()}
+		})
+	}
+	return wrapped
+}
+
+func (r *resourceWrapper) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		r.config = ResourceConfiguration(req, resp)
+	}
+
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+func (r *resourceWrapper) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.refuseWhenReadOnly(ctx, "create", &resp.Diagnostics) {
+		return
+	}
+
+	reference, spaceID := r.resolveSpaceReference(ctx, &req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := applyWriteOnlySecrets(ctx, req.Config, &req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Create(ctx, req, resp)
+
+	restoreSpaceReference(ctx, &resp.State, reference, spaceID, &resp.Diagnostics)
+	forgetWriteOnlySecrets(ctx, &resp.State, secrets, &resp.Diagnostics)
+	keepWriteOnlyVersions(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *resourceWrapper) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	reference, spaceID := r.resolveSpaceReference(ctx, &req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	replaceSpaceReference(ctx, &resp.State, reference, spaceID, &resp.Diagnostics)
+
+	r.Resource.Read(ctx, req, resp)
+
+	restoreSpaceReference(ctx, &resp.State, reference, spaceID, &resp.Diagnostics)
+	keepWriteOnlySecretsForgotten(ctx, req.State, &resp.State, &resp.Diagnostics)
+	keepWriteOnlyVersions(ctx, req.State, &resp.State, &resp.Diagnostics)
+}
+
+func (r *resourceWrapper) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.refuseWhenReadOnly(ctx, "update", &resp.Diagnostics) {
+		return
+	}
+
+	reference, spaceID := r.resolveSpaceReference(ctx, &req.Plan, &resp.Diagnostics)
+	r.resolveSpaceReference(ctx, &req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := applyWriteOnlySecrets(ctx, req.Config, &req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Update(ctx, req, resp)
+
+	restoreSpaceReference(ctx, &resp.State, reference, spaceID, &resp.Diagnostics)
+	forgetWriteOnlySecrets(ctx, &resp.State, secrets, &resp.Diagnostics)
+	keepWriteOnlyVersions(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *resourceWrapper) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.refuseWhenReadOnly(ctx, "delete", &resp.Diagnostics) {
+		return
+	}
+
+	r.resolveSpaceReference(ctx, &req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Delete(ctx, req, resp)
+}
+
+func (r *resourceWrapper) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	inner, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	inner.ImportState(ctx, req, resp)
+}
+
+func (r *resourceWrapper) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.checkVersionRequirements(ctx, req.Config, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if inner, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		inner.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *resourceWrapper) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if inner, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return inner.UpgradeState(ctx)
+	}
+	return nil
+}
+
+func (r *resourceWrapper) MoveState(ctx context.Context) []resource.StateMover {
+	if inner, ok := r.Resource.(resource.ResourceWithMoveState); ok {
+		return inner.MoveState(ctx)
+	}
+	return nil
+}
+
+func (r *resourceWrapper) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return inner.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *resourceWrapper) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		inner.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// refuseWhenReadOnly reports an error and returns true when the provider is read only.
+func (r *resourceWrapper) refuseWhenReadOnly(ctx context.Context, operation string, diags *diag.Diagnostics) bool {
+	if r.config == nil || !r.config.ReadOnly {
+		return false
+	}
+
+	diags.AddError(providerconfig.ReadOnlySummary, providerconfig.ReadOnlyDetail(operation, r.typeName(ctx)))
+	return true
+}
+
+// checkVersionRequirements checks the resource, and the attributes configured for it, against the version matrix when
+// it is planned, so configuration the connected server cannot accept fails before it is applied.
+func (r *resourceWrapper) checkVersionRequirements(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	if r.config == nil || plan.Raw.IsNull() {
+		return
+	}
+
+	for _, requirement := range providerconfig.VersionRequirements(r.typeName(ctx)) {
+		attributePath := path.Root(requirement.Attribute)
+		if requirement.Attribute != "" {
+			var value attr.Value
+			if config.GetAttribute(ctx, attributePath, &value).HasError() || value == nil || value.IsNull() {
+				continue
+			}
+		}
+
+		if incompatibility := r.config.CheckVersionRequirement(requirement); incompatibility != nil {
+			if requirement.Attribute != "" {
+				diags.AddAttributeError(attributePath, incompatibility.Summary, incompatibility.Detail)
+			} else {
+				diags.AddError(incompatibility.Summary, incompatibility.Detail)
+			}
+		}
+	}
+}
+
+func (r *resourceWrapper) typeName(ctx context.Context) string {
+	metadata := resource.MetadataResponse{}
+	r.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: util.GetProviderName()}, &metadata)
+	return metadata.TypeName
+}
+
+// resolveSpaceReference replaces a space name or slug in the space_id attribute of data with the space ID, returning
+// both so the reference can be restored once the wrapped resource has finished.
+func (r *resourceWrapper) resolveSpaceReference(ctx context.Context, data spaceAttributeData, diags *diag.Diagnostics) (string, string) {
+	if r.config == nil || r.config.Client == nil || !hasSpaceIDAttribute(data) {
+		return "", ""
+	}
+
+	var reference types.String
+	diags.Append(data.GetAttribute(ctx, path.Root(schemas.SchemaAttributeNames.SpaceID), &reference)...)
+	if diags.HasError() || reference.IsNull() || reference.IsUnknown() || spaceresolver.IsSpaceID(reference.ValueString()) {
+		return "", ""
+	}
+
+	spaceID, err := spaceresolver.ForClient(r.config.Client).Resolve(reference.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(schemas.SchemaAttributeNames.SpaceID), "Unable to resolve space", err.Error())
+		return "", ""
+	}
+
+	replaceSpaceReference(ctx, data, reference.ValueString(), spaceID, diags)
+	return reference.ValueString(), spaceID
+}
+
+func replaceSpaceReference(ctx context.Context, data spaceAttributeData, reference string, spaceID string, diags *diag.Diagnostics) {
+	if reference == "" || !hasSpaceIDAttribute(data) {
+		return
+	}
+
+	diags.Append(data.SetAttribute(ctx, path.Root(schemas.SchemaAttributeNames.SpaceID), types.StringValue(spaceID))...)
+}
+
+func restoreSpaceReference(ctx context.Context, state *tfsdk.State, reference string, spaceID string, diags *diag.Diagnostics) {
+	if reference == "" || state.Raw.IsNull() || !hasSpaceIDAttribute(state) {
+		return
+	}
+
+	var current types.String
+	diags.Append(state.GetAttribute(ctx, path.Root(schemas.SchemaAttributeNames.SpaceID), &current)...)
+	if current.ValueString() == spaceID {
+		diags.Append(state.SetAttribute(ctx, path.Root(schemas.SchemaAttributeNames.SpaceID), types.StringValue(reference))...)
+	}
+}
+
+type spaceAttributeData interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+	SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics
+}
+
+func hasSpaceIDAttribute(data spaceAttributeData) bool {
+	switch d := data.(type) {
+	case *tfsdk.Plan:
+		return !d.Raw.IsNull() && d.Schema != nil && d.Schema.GetAttributes()[schemas.SchemaAttributeNames.SpaceID] != nil
+	case *tfsdk.State:
+		return !d.Raw.IsNull() && d.Schema != nil && d.Schema.GetAttributes()[schemas.SchemaAttributeNames.SpaceID] != nil
+	}
+	return false
+}
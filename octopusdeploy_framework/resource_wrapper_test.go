@@ -0,0 +1,81 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/providerconfig"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeploymentFreezePlan(t *testing.T, withRecurringSchedule bool) (tfsdk.Config, tfsdk.Plan) {
+	schemaResponse := resource.SchemaResponse{}
+	NewDeploymentFreezeResource().Schema(context.Background(), resource.SchemaRequest{}, &schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	resourceSchema := schemaResponse.Schema
+	objectType := resourceSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "Freeze")
+
+	if withRecurringSchedule {
+		scheduleType := objectType.AttributeTypes["recurring_schedule"].(tftypes.Object)
+		schedule := map[string]tftypes.Value{}
+		for name, attributeType := range scheduleType.AttributeTypes {
+			schedule[name] = tftypes.NewValue(attributeType, nil)
+		}
+		schedule["type"] = tftypes.NewValue(tftypes.String, "Daily")
+		values["recurring_schedule"] = tftypes.NewValue(scheduleType, schedule)
+	}
+
+	raw := tftypes.NewValue(objectType, values)
+	return tfsdk.Config{Schema: resourceSchema, Raw: raw}, tfsdk.Plan{Schema: resourceSchema, Raw: raw}
+}
+
+func newWrappedDeploymentFreeze(octopusVersion string) *resourceWrapper {
+	return &resourceWrapper{
+		Resource: NewDeploymentFreezeResource(),
+		config:   &Config{Config: &providerconfig.Config{OctopusVersion: octopusVersion}},
+	}
+}
+
+func TestResourceWrapperRejectsUnsupportedResource(t *testing.T) {
+	config, plan := newDeploymentFreezePlan(t, false)
+
+	response := resource.ModifyPlanResponse{Plan: plan}
+	newWrappedDeploymentFreeze("2024.4").ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: config, Plan: plan}, &response)
+
+	require.True(t, response.Diagnostics.HasError())
+	require.Contains(t, response.Diagnostics[0].Detail(), "2025.1")
+}
+
+func TestResourceWrapperRejectsUnsupportedAttribute(t *testing.T) {
+	config, plan := newDeploymentFreezePlan(t, true)
+
+	response := resource.ModifyPlanResponse{Plan: plan}
+	newWrappedDeploymentFreeze("2025.1.1000").ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: config, Plan: plan}, &response)
+
+	require.True(t, response.Diagnostics.HasError())
+	require.Contains(t, response.Diagnostics[0].Summary(), "recurring_schedule")
+	require.Contains(t, response.Diagnostics[0].Detail(), "2025.2")
+}
+
+func TestResourceWrapperAcceptsSupportedConfiguration(t *testing.T) {
+	config, plan := newDeploymentFreezePlan(t, false)
+
+	response := resource.ModifyPlanResponse{Plan: plan}
+	newWrappedDeploymentFreeze("2025.1.1000").ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: config, Plan: plan}, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	config, plan = newDeploymentFreezePlan(t, true)
+	response = resource.ModifyPlanResponse{Plan: plan}
+	newWrappedDeploymentFreeze("2025.2").ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: config, Plan: plan}, &response)
+	require.False(t, response.Diagnostics.HasError())
+}
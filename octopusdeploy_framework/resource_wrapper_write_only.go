@@ -0,0 +1,106 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlySecrets returns the secret attributes of the resource that have a write-only variant.
+func writeOnlySecrets(s interface{}) []string {
+	resourceSchemaValue, ok := s.(resourceSchema.Schema)
+	if !ok {
+		return nil
+	}
+	return schemas.WriteOnlyAttributes(resourceSchemaValue.Attributes)
+}
+
+// applyWriteOnlySecrets copies each configured write-only secret, such as password_wo, into its counterpart in the
+// plan, such as password, so the wrapped resource sends it to Octopus like any other secret. It returns the secrets it
+// copied, which must be removed from state with forgetWriteOnlySecrets once the wrapped resource has finished.
+func applyWriteOnlySecrets(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan, diags *diag.Diagnostics) []string {
+	if plan.Raw.IsNull() {
+		return nil
+	}
+
+	var applied []string
+	for _, secret := range writeOnlySecrets(plan.Schema) {
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(secret+schemas.WriteOnlySuffix), &value)...)
+		if diags.HasError() {
+			return nil
+		}
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		diags.Append(plan.SetAttribute(ctx, path.Root(secret), value)...)
+		applied = append(applied, secret)
+	}
+
+	return applied
+}
+
+// forgetWriteOnlySecrets removes the secrets copied by applyWriteOnlySecrets from state, which records only the
+// version of each write-only secret.
+func forgetWriteOnlySecrets(ctx context.Context, state *tfsdk.State, secrets []string, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return
+	}
+
+	for _, secret := range secrets {
+		diags.Append(state.SetAttribute(ctx, path.Root(secret), types.StringNull())...)
+	}
+}
+
+// keepWriteOnlyVersions copies the version of each write-only secret from source into state, so a wrapped resource
+// that builds its state from the Octopus API, which knows nothing of versions, does not lose them.
+func keepWriteOnlyVersions(ctx context.Context, source writeOnlyVersionSource, state *tfsdk.State, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return
+	}
+
+	for _, secret := range writeOnlySecrets(state.Schema) {
+		versionPath := path.Root(secret + schemas.WriteOnlyVersionSuffix)
+
+		var version types.Int64
+		diags.Append(source.GetAttribute(ctx, versionPath, &version)...)
+		if diags.HasError() {
+			return
+		}
+
+		diags.Append(state.SetAttribute(ctx, versionPath, version)...)
+	}
+}
+
+type writeOnlyVersionSource interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// keepWriteOnlySecretsForgotten keeps secrets that are absent from the prior state, as they are when set through their
+// write-only variant, out of the state written by Read, in case the wrapped resource reads them back from Octopus.
+func keepWriteOnlySecretsForgotten(ctx context.Context, prior tfsdk.State, state *tfsdk.State, diags *diag.Diagnostics) {
+	if prior.Raw.IsNull() || state.Raw.IsNull() {
+		return
+	}
+
+	var forgotten []string
+	for _, secret := range writeOnlySecrets(state.Schema) {
+		var value types.String
+		diags.Append(prior.GetAttribute(ctx, path.Root(secret), &value)...)
+		if diags.HasError() {
+			return
+		}
+
+		if value.IsNull() {
+			forgotten = append(forgotten, secret)
+		}
+	}
+
+	forgetWriteOnlySecrets(ctx, state, forgotten, diags)
+}
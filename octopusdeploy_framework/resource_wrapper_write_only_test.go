@@ -0,0 +1,145 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// secretResource stands in for a resource with a password, recording the password it is asked to send to Octopus and
+// reading back whatever Octopus returns.
+type secretResource struct {
+	sentPassword   types.String
+	storedPassword types.String
+}
+
+func (r *secretResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "octopusdeploy_secret"
+}
+
+func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	s := resourceSchema.Schema{
+		Attributes: map[string]resourceSchema.Attribute{
+			"id":       schemas.GetIdResourceSchema(),
+			"password": schemas.GetPasswordResourceSchema(true),
+		},
+	}
+	schemas.AddWriteOnlyAttributes(s.Attributes, "password")
+	resp.Schema = s
+}
+
+func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password"), &r.sentPassword)...)
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue("Secrets-1"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password_wo_version"), types.Int64Null())...)
+}
+
+func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.State.Raw = req.State.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), r.storedPassword)...)
+}
+
+func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password"), &r.sentPassword)...)
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *secretResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func newSecretRequest(t *testing.T, inner *secretResource, values map[string]tftypes.Value) (tfsdk.Config, tfsdk.Plan, tfsdk.State) {
+	schemaResponse := resource.SchemaResponse{}
+	inner.Schema(context.Background(), resource.SchemaRequest{}, &schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	s := schemaResponse.Schema
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	configValues := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		configValues[name] = value
+	}
+
+	// Terraform never plans a write-only value, so the plan only has the version.
+	planValues := map[string]tftypes.Value{}
+	for name, value := range configValues {
+		planValues[name] = value
+	}
+	planValues["password_wo"] = tftypes.NewValue(tftypes.String, nil)
+
+	config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, configValues)}
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, planValues)}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)}
+	return config, plan, state
+}
+
+func TestResourceWrapperSendsWriteOnlySecretWithoutStoringIt(t *testing.T) {
+	inner := &secretResource{}
+	wrapper := &resourceWrapper{Resource: inner}
+	config, plan, state := newSecretRequest(t, inner, map[string]tftypes.Value{
+		"password_wo":         tftypes.NewValue(tftypes.String, "hunter2"),
+		"password_wo_version": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	createResponse := resource.CreateResponse{State: state}
+	wrapper.Create(context.Background(), resource.CreateRequest{Config: config, Plan: plan}, &createResponse)
+	require.False(t, createResponse.Diagnostics.HasError())
+	require.Equal(t, "hunter2", inner.sentPassword.ValueString())
+
+	var password types.String
+	var version types.Int64
+	createResponse.State.GetAttribute(context.Background(), path.Root("password"), &password)
+	createResponse.State.GetAttribute(context.Background(), path.Root("password_wo_version"), &version)
+	require.True(t, password.IsNull())
+	require.Equal(t, int64(1), version.ValueInt64())
+
+	inner.storedPassword = types.StringValue("hunter2")
+	readResponse := resource.ReadResponse{State: createResponse.State}
+	wrapper.Read(context.Background(), resource.ReadRequest{State: createResponse.State}, &readResponse)
+	require.False(t, readResponse.Diagnostics.HasError())
+
+	readResponse.State.GetAttribute(context.Background(), path.Root("password"), &password)
+	require.True(t, password.IsNull())
+}
+
+func TestResourceWrapperKeepsSecretsThatAreNotWriteOnly(t *testing.T) {
+	inner := &secretResource{}
+	wrapper := &resourceWrapper{Resource: inner}
+	config, plan, state := newSecretRequest(t, inner, map[string]tftypes.Value{
+		"password": tftypes.NewValue(tftypes.String, "hunter2"),
+	})
+
+	createResponse := resource.CreateResponse{State: state}
+	wrapper.Create(context.Background(), resource.CreateRequest{Config: config, Plan: plan}, &createResponse)
+	require.False(t, createResponse.Diagnostics.HasError())
+	require.Equal(t, "hunter2", inner.sentPassword.ValueString())
+
+	var password types.String
+	createResponse.State.GetAttribute(context.Background(), path.Root("password"), &password)
+	require.Equal(t, "hunter2", password.ValueString())
+}
+
+func TestAddWriteOnlyAttributesMakesRequiredSecretOptional(t *testing.T) {
+	attributes := map[string]resourceSchema.Attribute{
+		"password": schemas.GetPasswordResourceSchema(true),
+	}
+	schemas.AddWriteOnlyAttributes(attributes, "password")
+
+	require.True(t, attributes["password"].IsOptional())
+	require.False(t, attributes["password"].IsRequired())
+	require.True(t, attributes["password_wo"].IsWriteOnly())
+	require.True(t, attributes["password_wo"].IsSensitive())
+	require.True(t, attributes["password_wo_version"].IsOptional())
+	require.Equal(t, []string{"password"}, schemas.WriteOnlyAttributes(attributes))
+}
@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	ephemeralSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ApiKeyResourceDescription = "api key"
+
+// ApiKeyDefaultPurpose is the purpose recorded against API keys created without one.
+const ApiKeyDefaultPurpose = "Created by Terraform"
+
+type ApiKeyModel struct {
+	ID        types.String `tfsdk:"id"`
+	UserID    types.String `tfsdk:"user_id"`
+	Purpose   types.String `tfsdk:"purpose"`
+	ExpiresIn types.String `tfsdk:"expires_in"`
+	Expires   types.String `tfsdk:"expires"`
+	ApiKey    types.String `tfsdk:"api_key"`
+}
+
+type ApiKeySchema struct{}
+
+func (a ApiKeySchema) GetEphemeralResourceSchema() ephemeralSchema.Schema {
+	return ephemeralSchema.Schema{
+		Description: "Creates an API key for a user or service account that is valid while Terraform runs. The key is revoked when Terraform no longer needs it and is never written to state or to the plan.",
+		Attributes: map[string]ephemeralSchema.Attribute{
+			"id": ephemeralSchema.StringAttribute{
+				Description: "The unique ID for this resource.",
+				Computed:    true,
+			},
+			"user_id": ephemeralSchema.StringAttribute{
+				Description: "The ID of the user or service account the API key authenticates as.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"purpose": ephemeralSchema.StringAttribute{
+				Description: "The purpose recorded against the API key. Defaults to `" + ApiKeyDefaultPurpose + "`.",
+				Optional:    true,
+			},
+			"expires_in": ephemeralSchema.StringAttribute{
+				Description: "How long the API key is valid for, as a duration such as `30m` or `2h`. The Octopus Server rejects the key after it expires even if Terraform could not revoke it. When unset, the key does not expire and is only revoked by Terraform.",
+				Optional:    true,
+			},
+			"expires": ephemeralSchema.StringAttribute{
+				Description: "When the API key expires, in RFC 3339 format, or empty when it does not expire.",
+				Computed:    true,
+			},
+			"api_key": ephemeralSchema.StringAttribute{
+				Description: "The API key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -13,7 +13,7 @@ type ArtifactoryGenericFeedSchema struct{}
 var _ EntitySchema = ArtifactoryGenericFeedSchema{}
 
 func (a ArtifactoryGenericFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a Artifactory Generic feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"feed_uri": resourceSchema.StringAttribute{
@@ -36,6 +36,10 @@ func (a ArtifactoryGenericFeedSchema) GetResourceSchema() resourceSchema.Schema
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (a ArtifactoryGenericFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -53,4 +57,5 @@ type ArtifactoryGenericFeedTypeResourceModel struct {
 	LayoutRegex                       types.String `tfsdk:"layout_regex"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
@@ -22,7 +22,7 @@ func (a AwsElasticContainerRegistrySchema) GetDatasourceSchema() datasourceSchem
 }
 
 func (a AwsElasticContainerRegistrySchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages an AWS Elastic Container Registry in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"access_key": resourceSchema.StringAttribute{
@@ -77,6 +77,10 @@ func (a AwsElasticContainerRegistrySchema) GetResourceSchema() resourceSchema.Sc
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "secret_key")
+
+	return s
 }
 
 type AwsElasticContainerRegistryFeedTypeResourceModel struct {
@@ -89,6 +93,7 @@ type AwsElasticContainerRegistryFeedTypeResourceModel struct {
 	OidcAuthentication                *EcrOidcAuthenticationResourceModel `tfsdk:"oidc_authentication"`
 
 	ResourceModel
+	SecretKeyWriteOnlyModel
 }
 
 type EcrOidcAuthenticationResourceModel struct {
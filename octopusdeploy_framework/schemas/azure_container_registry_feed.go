@@ -12,7 +12,7 @@ type AzureContainerRegistryFeedSchema struct{}
 var _ EntitySchema = AzureContainerRegistryFeedSchema{}
 
 func (d AzureContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages Azure Container Registry feed in Octopus Deploy (alias of Docker Container Registry feed)",
 		Attributes: map[string]resourceSchema.Attribute{
 			"api_version": resourceSchema.StringAttribute{
@@ -51,6 +51,10 @@ func (d AzureContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Sch
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (d AzureContainerRegistryFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -68,6 +72,7 @@ type AzureContainerRegistryFeedTypeResourceModel struct {
 	OidcAuthentication *AzureContainerRegistryOidcAuthenticationResourceModel `tfsdk:"oidc_authentication"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
 
 type AzureContainerRegistryOidcAuthenticationResourceModel struct {
@@ -40,10 +40,12 @@ type CertificateModel struct {
 	Version                  types.Int64  `tfsdk:"version"`
 
 	ResourceModel
+	CertificateDataWriteOnlyModel
+	PasswordWriteOnlyModel
 }
 
 func (c CertificateSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages certificates in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"archived": resourceSchema.StringAttribute{
@@ -165,4 +167,8 @@ func (c CertificateSchema) GetResourceSchema() resourceSchema.Schema {
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "certificate_data", "password")
+
+	return s
 }
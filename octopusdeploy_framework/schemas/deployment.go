@@ -0,0 +1,78 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const DeploymentResourceDescription = "deployment"
+
+type DeploymentSchema struct{}
+
+var _ EntitySchema = DeploymentSchema{}
+
+func (d DeploymentSchema) GetResourceSchema() resourceSchema.Schema {
+	s := resourceSchema.Schema{
+		Description: "This resource deploys a release to an environment in Octopus Deploy, and optionally waits for the deployment to complete. Any change to the deployment configuration deploys the release again.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this deployment.").
+				Build(),
+			"release_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The ID of the release to deploy.").
+				Build(),
+			"environment_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The ID of the environment to deploy the release to.").
+				Build(),
+			"tenant_ids": util.ResourceSet(types.StringType).
+				Optional().
+				PlanModifiers(setplanmodifier.RequiresReplace()).
+				Validators(setvalidator.SizeAtLeast(1)).
+				Description("The IDs of the tenants to deploy the release for. A deployment is created for each tenant.").
+				Build(),
+			"deployment_ids": util.ResourceList(types.StringType).
+				Computed().
+				PlanModifiers(listplanmodifier.UseStateForUnknown()).
+				Description("The IDs of the deployments created, one for each tenant.").
+				Build(),
+			"task_ids": util.ResourceList(types.StringType).
+				Computed().
+				PlanModifiers(listplanmodifier.UseStateForUnknown()).
+				Description("The IDs of the server tasks that run the deployments.").
+				Build(),
+		},
+	}
+
+	addServerTaskExecutionAttributes(s.Attributes, "deployment")
+	return s
+}
+
+func (d DeploymentSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type DeploymentResourceModel struct {
+	SpaceID       types.String `tfsdk:"space_id"`
+	ReleaseID     types.String `tfsdk:"release_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	TenantIDs     types.Set    `tfsdk:"tenant_ids"`
+	DeploymentIDs types.List   `tfsdk:"deployment_ids"`
+	TaskIDs       types.List   `tfsdk:"task_ids"`
+
+	ServerTaskExecutionModel
+	ResourceModel
+}
@@ -0,0 +1,440 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notWhitespaceRegex matches values that contain more than whitespace.
+var notWhitespaceRegex = regexp.MustCompile(`\S`)
+
+const deprecatedActionPropertiesMessage = "This attribute is deprecated and will be removed in a future release. Please use the attributes that match the properties that are stored to this map."
+
+// typedActionBlocks maps the action types that have their own action block to the name of the block.
+var typedActionBlocks = map[string]string{
+	"Octopus.AwsApplyCloudFormationChangeSet": "apply_aws_cloudformation_change_set_action",
+	"Octopus.AwsDeleteCloudFormation":         "delete_aws_cloudformation_stack_action",
+	"Octopus.AwsRunCloudFormation":            "deploy_aws_cloudformation_template_action",
+	"Octopus.AzureResourceGroup":              "deploy_azure_resource_group_action",
+	"Octopus.HelmChartUpgrade":                "deploy_helm_chart_action",
+	"Octopus.KubernetesDeployRawYaml":         "deploy_kubernetes_yaml_action",
+	"Octopus.KubernetesDeploySecret":          "deploy_kubernetes_secret_action",
+	"Octopus.KubernetesRunScript":             "run_kubectl_script_action",
+	"Octopus.Kustomize":                       "deploy_kustomize_action",
+	"Octopus.Manual":                          "manual_intervention_action",
+	"Octopus.Script":                          "run_script_action",
+	"Octopus.TentaclePackage":                 "deploy_package_action",
+	"Octopus.TerraformApply":                  "apply_terraform_template_action",
+	"Octopus.WindowsService":                  "deploy_windows_service_action",
+}
+
+// GetActionBlockName returns the name of the block an action of the given type is read into: its own action block, or
+// the generic action block.
+func GetActionBlockName(actionType string) string {
+	if block, ok := typedActionBlocks[actionType]; ok {
+		return block
+	}
+	return "action"
+}
+
+// getDeploymentActionBlock returns the generic action block, which runs an action of any type configured through its
+// properties.
+func getDeploymentActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	attributes["action_type"] = util.ResourceString().
+		Required().
+		Validators(typedActionTypeValidator{}).
+		Description("The type of action").
+		Build()
+	addActionExecutionAttributes(attributes)
+	addActionPackageBlocks(blocks)
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action of any type, configured through its properties.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// getActionAttributes returns the attributes and blocks shared by every action block.
+func getActionAttributes() (map[string]resourceSchema.Attribute, map[string]resourceSchema.Block) {
+	attributes := map[string]resourceSchema.Attribute{
+		"can_be_used_for_project_versioning": util.ResourceBool().
+			Optional().
+			Computed().
+			Build(),
+		"channels": util.ResourceList(types.StringType).
+			Optional().
+			Computed().
+			Description("The channels associated with this deployment action.").
+			Build(),
+		"condition": util.ResourceString().
+			Optional().
+			Computed().
+			Description("The condition associated with this deployment action.").
+			Build(),
+		"environments": util.ResourceList(types.StringType).
+			Optional().
+			Computed().
+			Description("The environments within which this deployment action will run.").
+			Build(),
+		"excluded_environments": util.ResourceList(types.StringType).
+			Optional().
+			Computed().
+			Description("The environments that this step will be skipped in").
+			Build(),
+		"features": util.ResourceList(types.StringType).
+			Optional().
+			Computed().
+			Description("A list of enabled features for this action.").
+			Build(),
+		"id": util.ResourceString().
+			Optional().
+			Computed().
+			Description("The unique ID for this resource.").
+			Build(),
+		"is_disabled": util.ResourceBool().
+			Optional().
+			Computed().
+			Default(false).
+			Description("Indicates the disabled status of this deployment action.").
+			Build(),
+		"is_required": util.ResourceBool().
+			Optional().
+			Computed().
+			Default(false).
+			Description("Indicates the required status of this deployment action.").
+			Build(),
+		"name": util.ResourceString().
+			Required().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The name of this resource.").
+			Build(),
+		"notes": util.ResourceString().
+			Optional().
+			Description("The notes associated with this deployment action.").
+			Build(),
+		"properties": util.ResourceMap(types.StringType).
+			Optional().
+			Computed().
+			Validators(runOnServerPropertyValidator{}, NewOctostachePropertiesValidator()).
+			Description("The properties associated with this deployment action.").
+			Build(),
+		"slug": util.ResourceString().
+			Computed().
+			Description("The human-readable unique identifier for this resource.").
+			Build(),
+		"sort_order": util.ResourceInt64().
+			Optional().
+			Computed().
+			Description("Order used by terraform to ensure correct ordering of actions. This property must be either omitted from all actions, or provided on all actions").
+			Build(),
+		"tenant_tags": util.ResourceList(types.StringType).
+			Optional().
+			Computed().
+			Description("A list of tenant tags associated with this resource.").
+			Build(),
+	}
+
+	blocks := map[string]resourceSchema.Block{
+		"action_template": resourceSchema.SetNestedBlock{
+			Description: "Represents the template that is associated with this action.",
+			NestedObject: resourceSchema.NestedBlockObject{
+				Attributes: map[string]resourceSchema.Attribute{
+					"community_action_template_id": util.ResourceString().
+						Optional().
+						Computed().
+						Build(),
+					"id": util.ResourceString().
+						Required().
+						Build(),
+					"version": util.ResourceInt64().
+						Optional().
+						Computed().
+						Build(),
+				},
+			},
+			Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+		},
+		"container": resourceSchema.ListNestedBlock{
+			Description: "The deployment action container associated with this deployment action.",
+			NestedObject: resourceSchema.NestedBlockObject{
+				Attributes: map[string]resourceSchema.Attribute{
+					"feed_id": util.ResourceString().
+						Optional().
+						Build(),
+					"image": util.ResourceString().
+						Optional().
+						Build(),
+				},
+			},
+		},
+		"git_dependency": getGitDependencyBlock(),
+		"package":        getPackageReferenceBlock(),
+	}
+
+	return attributes, blocks
+}
+
+// addActionExecutionAttributes adds the attributes that decide where an action runs.
+func addActionExecutionAttributes(attributes map[string]resourceSchema.Attribute) {
+	attributes["run_on_server"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(false).
+		Description("Whether this step runs on a worker or on the target").
+		Build()
+	attributes["worker_pool_id"] = util.ResourceString().
+		Optional().
+		Description("The worker pool associated with this deployment action.").
+		Build()
+	attributes["worker_pool_variable"] = util.ResourceString().
+		Optional().
+		Description("The worker pool variable associated with this deployment action.").
+		Build()
+}
+
+// addActionPackageBlocks adds an optional primary package, and replaces the package block with one whose packages
+// are named and can be extracted during the deployment.
+func addActionPackageBlocks(blocks map[string]resourceSchema.Block) {
+	blocks["primary_package"] = getPrimaryPackageBlock(false)
+
+	packageBlock := getPackageReferenceBlock()
+	packageBlock.NestedObject.Attributes["name"] = util.ResourceString().
+		Required().
+		Description("The name of the package").
+		Build()
+	packageBlock.NestedObject.Attributes["extract_during_deployment"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Description("Whether to extract the package during deployment").
+		Build()
+	blocks["package"] = packageBlock
+}
+
+func getPrimaryPackageBlock(required bool) resourceSchema.ListNestedBlock {
+	block := getPackageReferenceBlock()
+	block.Validators = []validator.List{listvalidator.SizeAtMost(1)}
+	if required {
+		block.Validators = append(block.Validators, listvalidator.IsRequired())
+	}
+	return block
+}
+
+func getPackageReferenceBlock() resourceSchema.ListNestedBlock {
+	return resourceSchema.ListNestedBlock{
+		Description: "The package assocated with this action.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"acquisition_location": util.ResourceString().
+					Optional().
+					Computed().
+					Default("Server").
+					Description("Whether to acquire this package on the server ('Server'), target ('ExecutionTarget') or not at all ('NotAcquired'). Can be an expression").
+					Build(),
+				"feed_id": util.ResourceString().
+					Optional().
+					Computed().
+					Default("feeds-builtin").
+					Description("The feed ID associated with this package reference.").
+					Build(),
+				"id": util.ResourceString().
+					Optional().
+					Computed().
+					Description("The unique ID for this resource.").
+					Build(),
+				"name": util.ResourceString().
+					Optional().
+					Validators(stringvalidator.LengthAtLeast(1)).
+					Description("The name of this resource.").
+					Build(),
+				"package_id": util.ResourceString().
+					Required().
+					Description("The ID of the package.").
+					Build(),
+				"properties": util.ResourceMap(types.StringType).
+					Optional().
+					Computed().
+					Description("A list of properties associated with this package.").
+					Build(),
+			},
+		},
+	}
+}
+
+func getGitDependencyBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		Description: "Configuration for resource sourcing from a git repository.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"repository_uri": util.ResourceString().
+					Required().
+					Validators(stringvalidator.RegexMatches(notWhitespaceRegex, "must not be empty or consist only of whitespace")).
+					Description("The Git URI for the repository where this resource is sourced from.").
+					Build(),
+				"default_branch": util.ResourceString().
+					Required().
+					Validators(stringvalidator.RegexMatches(notWhitespaceRegex, "must not be empty or consist only of whitespace")).
+					Description("Name of the default branch of the repository.").
+					Build(),
+				"git_credential_type": util.ResourceString().
+					Required().
+					Validators(stringvalidator.RegexMatches(notWhitespaceRegex, "must not be empty or consist only of whitespace")).
+					Description("The Git credential authentication type.").
+					Build(),
+				"file_path_filters": util.ResourceList(types.StringType).
+					Optional().
+					Description("List of file path filters used to narrow down the directory where files are to be sourced from. Supports glob patten syntax.").
+					Build(),
+				"git_credential_id": util.ResourceString().
+					Optional().
+					Description("ID of an existing Git credential.").
+					Build(),
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+}
+
+// DeploymentActionBaseModel holds the attributes shared by every action block. The package block is left to the
+// action models, as some action blocks name their packages.
+type DeploymentActionBaseModel struct {
+	ActionTemplate                []ActionTemplateModel            `tfsdk:"action_template"`
+	CanBeUsedForProjectVersioning types.Bool                       `tfsdk:"can_be_used_for_project_versioning"`
+	Channels                      types.List                       `tfsdk:"channels"`
+	Condition                     types.String                     `tfsdk:"condition"`
+	Container                     []DeploymentActionContainerModel `tfsdk:"container"`
+	Environments                  types.List                       `tfsdk:"environments"`
+	ExcludedEnvironments          types.List                       `tfsdk:"excluded_environments"`
+	Features                      types.List                       `tfsdk:"features"`
+	GitDependency                 []GitDependencyModel             `tfsdk:"git_dependency"`
+	ID                            types.String                     `tfsdk:"id"`
+	IsDisabled                    types.Bool                       `tfsdk:"is_disabled"`
+	IsRequired                    types.Bool                       `tfsdk:"is_required"`
+	Name                          types.String                     `tfsdk:"name"`
+	Notes                         types.String                     `tfsdk:"notes"`
+	Properties                    types.Map                        `tfsdk:"properties"`
+	Slug                          types.String                     `tfsdk:"slug"`
+	SortOrder                     types.Int64                      `tfsdk:"sort_order"`
+	TenantTags                    types.List                       `tfsdk:"tenant_tags"`
+}
+
+// ActionBase returns the attributes shared by every action block, so they can be reached from any action model.
+func (m DeploymentActionBaseModel) ActionBase() DeploymentActionBaseModel {
+	return m
+}
+
+// DeploymentActionExecutionModel holds the attributes that decide where an action runs.
+type DeploymentActionExecutionModel struct {
+	RunOnServer        types.Bool   `tfsdk:"run_on_server"`
+	WorkerPoolID       types.String `tfsdk:"worker_pool_id"`
+	WorkerPoolVariable types.String `tfsdk:"worker_pool_variable"`
+}
+
+// DeploymentActionModel is the generic action block.
+type DeploymentActionModel struct {
+	ActionType     types.String                 `tfsdk:"action_type"`
+	PrimaryPackage []PackageReferenceModel      `tfsdk:"primary_package"`
+	Packages       []NamedPackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+}
+
+type ActionTemplateModel struct {
+	CommunityActionTemplateID types.String `tfsdk:"community_action_template_id"`
+	ID                        types.String `tfsdk:"id"`
+	Version                   types.Int64  `tfsdk:"version"`
+}
+
+type DeploymentActionContainerModel struct {
+	FeedID types.String `tfsdk:"feed_id"`
+	Image  types.String `tfsdk:"image"`
+}
+
+type GitDependencyModel struct {
+	RepositoryUri     types.String `tfsdk:"repository_uri"`
+	DefaultBranch     types.String `tfsdk:"default_branch"`
+	GitCredentialType types.String `tfsdk:"git_credential_type"`
+	FilePathFilters   types.List   `tfsdk:"file_path_filters"`
+	GitCredentialID   types.String `tfsdk:"git_credential_id"`
+}
+
+type PackageReferenceModel struct {
+	AcquisitionLocation types.String `tfsdk:"acquisition_location"`
+	FeedID              types.String `tfsdk:"feed_id"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	PackageID           types.String `tfsdk:"package_id"`
+	Properties          types.Map    `tfsdk:"properties"`
+}
+
+// NamedPackageReferenceModel is a package of an action block that names its packages.
+type NamedPackageReferenceModel struct {
+	ExtractDuringDeployment types.Bool `tfsdk:"extract_during_deployment"`
+
+	PackageReferenceModel
+}
+
+// typedActionTypeValidator warns when the generic action block is used for an action type that has its own block.
+type typedActionTypeValidator struct{}
+
+func (v typedActionTypeValidator) Description(ctx context.Context) string {
+	return "warns when the action type has its own action block"
+}
+
+func (v typedActionTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v typedActionTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if block, ok := typedActionBlocks[req.ConfigValue.ValueString()]; ok {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"This action type has its own explicit action type",
+			fmt.Sprintf("Please use the new \"%s\" instead of the generic \"action\".", block),
+		)
+	}
+}
+
+// runOnServerPropertyValidator warns when the properties of an action set whether it runs on the server, which is
+// configured by run_on_server instead.
+type runOnServerPropertyValidator struct{}
+
+func (v runOnServerPropertyValidator) Description(ctx context.Context) string {
+	return "warns when the properties include Octopus.Action.RunOnServer"
+}
+
+func (v runOnServerPropertyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v runOnServerPropertyValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	const key = "Octopus.Action.RunOnServer"
+	if _, ok := req.ConfigValue.Elements()[key]; ok {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtMapKey(key),
+			fmt.Sprintf("\"%s\" is defined in properties", key),
+			"Please update your template to specify \"run_on_server\" under the action instead.",
+		)
+	}
+}
@@ -0,0 +1,236 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getApplyTerraformTemplateActionBlock returns the block of actions that apply a Terraform template
+// (Octopus.TerraformApply).
+func getApplyTerraformTemplateActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	attributes["inline_template"] = util.ResourceString().
+		Optional().
+		Description("The inline Terraform template, when the template is not sourced from a package.").
+		Build()
+	attributes["template_parameters"] = util.ResourceString().
+		Optional().
+		Description("The JSON encoded values of the variables of the inline template.").
+		Build()
+	blocks["primary_package"] = getPrimaryPackageBlock(false)
+	blocks["advanced_options"] = getTerraformTemplateAdvancedOptionsBlock()
+	blocks["aws_account"] = getTerraformTemplateAwsAccountBlock()
+	blocks["azure_account"] = getTerraformTemplateAzureAccountBlock()
+	blocks["google_cloud_account"] = getTerraformTemplateGoogleAccountBlock()
+	blocks["template"] = getTerraformTemplateBlock()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that applies a Terraform template.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+func getTerraformTemplateAdvancedOptionsBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		Description: "Optional advanced options for Terraform",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"allow_additional_plugin_downloads": util.ResourceBool().
+					Optional().
+					Computed().
+					Default(true).
+					Build(),
+				"apply_parameters": util.ResourceString().
+					Optional().
+					Build(),
+				"init_parameters": util.ResourceString().
+					Optional().
+					Build(),
+				"plugin_cache_directory": util.ResourceString().
+					Optional().
+					Build(),
+				"workspace": util.ResourceString().
+					Optional().
+					Build(),
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1), setvalidator.IsRequired()},
+	}
+}
+
+func getTerraformTemplateAwsAccountBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"region": util.ResourceString().
+					Optional().
+					Build(),
+				"variable": util.ResourceString().
+					Optional().
+					Build(),
+				"use_instance_role": util.ResourceBool().
+					Optional().
+					Build(),
+			},
+			Blocks: map[string]resourceSchema.Block{
+				"role": resourceSchema.SetNestedBlock{
+					NestedObject: resourceSchema.NestedBlockObject{
+						Attributes: map[string]resourceSchema.Attribute{
+							"arn": util.ResourceString().
+								Optional().
+								Build(),
+							"external_id": util.ResourceString().
+								Optional().
+								Build(),
+							"role_session_name": util.ResourceString().
+								Optional().
+								Build(),
+							"session_duration": util.ResourceInt64().
+								Optional().
+								Build(),
+						},
+					},
+					Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+				},
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+}
+
+func getTerraformTemplateAzureAccountBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"variable": util.ResourceString().
+					Optional().
+					Build(),
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+}
+
+func getTerraformTemplateGoogleAccountBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"variable": util.ResourceString().
+					Optional().
+					Build(),
+				"use_vm_service_account": util.ResourceBool().
+					Optional().
+					Description("When running in a Compute Engine virtual machine, use the associated VM service account").
+					Build(),
+				"project": util.ResourceString().
+					Optional().
+					Description("This sets GOOGLE_PROJECT environment variable").
+					Build(),
+				"region": util.ResourceString().
+					Optional().
+					Description("This sets GOOGLE_REGION environment variable").
+					Build(),
+				"zone": util.ResourceString().
+					Optional().
+					Description("This sets GOOGLE_ZONE environment variable").
+					Build(),
+				"service_account_emails": util.ResourceString().
+					Optional().
+					Description("This sets GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variable").
+					Build(),
+				"impersonate_service_account": util.ResourceBool().
+					Optional().
+					Description("Impersonate service accounts").
+					Build(),
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+}
+
+func getTerraformTemplateBlock() resourceSchema.SetNestedBlock {
+	return resourceSchema.SetNestedBlock{
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"additional_variable_files": util.ResourceString().
+					Optional().
+					Build(),
+				"directory": util.ResourceString().
+					Optional().
+					Build(),
+				"run_automatic_file_substitution": util.ResourceBool().
+					Optional().
+					Build(),
+				"target_files": util.ResourceString().
+					Optional().
+					Build(),
+			},
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+}
+
+type ApplyTerraformTemplateActionModel struct {
+	InlineTemplate     types.String                            `tfsdk:"inline_template"`
+	TemplateParameters types.String                            `tfsdk:"template_parameters"`
+	PrimaryPackage     []PackageReferenceModel                 `tfsdk:"primary_package"`
+	Packages           []PackageReferenceModel                 `tfsdk:"package"`
+	AdvancedOptions    []TerraformTemplateAdvancedOptionsModel `tfsdk:"advanced_options"`
+	AwsAccount         []TerraformTemplateAwsAccountModel      `tfsdk:"aws_account"`
+	AzureAccount       []TerraformTemplateAzureAccountModel    `tfsdk:"azure_account"`
+	GoogleCloudAccount []TerraformTemplateGoogleAccountModel   `tfsdk:"google_cloud_account"`
+	Template           []TerraformTemplateModel                `tfsdk:"template"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+}
+
+type TerraformTemplateAdvancedOptionsModel struct {
+	AllowAdditionalPluginDownloads types.Bool   `tfsdk:"allow_additional_plugin_downloads"`
+	ApplyParameters                types.String `tfsdk:"apply_parameters"`
+	InitParameters                 types.String `tfsdk:"init_parameters"`
+	PluginCacheDirectory           types.String `tfsdk:"plugin_cache_directory"`
+	Workspace                      types.String `tfsdk:"workspace"`
+}
+
+type TerraformTemplateAwsAccountModel struct {
+	Region          types.String                    `tfsdk:"region"`
+	Role            []TerraformTemplateAwsRoleModel `tfsdk:"role"`
+	Variable        types.String                    `tfsdk:"variable"`
+	UseInstanceRole types.Bool                      `tfsdk:"use_instance_role"`
+}
+
+type TerraformTemplateAwsRoleModel struct {
+	Arn             types.String `tfsdk:"arn"`
+	ExternalID      types.String `tfsdk:"external_id"`
+	RoleSessionName types.String `tfsdk:"role_session_name"`
+	SessionDuration types.Int64  `tfsdk:"session_duration"`
+}
+
+type TerraformTemplateAzureAccountModel struct {
+	Variable types.String `tfsdk:"variable"`
+}
+
+type TerraformTemplateGoogleAccountModel struct {
+	Variable                  types.String `tfsdk:"variable"`
+	UseVMServiceAccount       types.Bool   `tfsdk:"use_vm_service_account"`
+	Project                   types.String `tfsdk:"project"`
+	Region                    types.String `tfsdk:"region"`
+	Zone                      types.String `tfsdk:"zone"`
+	ServiceAccountEmails      types.String `tfsdk:"service_account_emails"`
+	ImpersonateServiceAccount types.Bool   `tfsdk:"impersonate_service_account"`
+}
+
+type TerraformTemplateModel struct {
+	AdditionalVariableFiles      types.String `tfsdk:"additional_variable_files"`
+	Directory                    types.String `tfsdk:"directory"`
+	RunAutomaticFileSubstitution types.Bool   `tfsdk:"run_automatic_file_substitution"`
+	TargetFiles                  types.String `tfsdk:"target_files"`
+}
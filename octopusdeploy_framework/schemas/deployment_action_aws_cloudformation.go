@@ -0,0 +1,281 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployAwsCloudFormationTemplateActionBlock returns the block of actions that deploy an AWS CloudFormation
+// template (Octopus.AwsRunCloudFormation).
+func getDeployAwsCloudFormationTemplateActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getAwsCloudFormationActionAttributes()
+	addTemplateSourceAttributes(attributes, blocks)
+	attributes["capabilities"] = util.ResourceList(types.StringType).
+		Optional().
+		Validators(listvalidator.ValueStringsAre(stringvalidator.OneOf("CAPABILITY_AUTO_EXPAND", "CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"))).
+		Description("The capabilities the stack is allowed: `CAPABILITY_AUTO_EXPAND`, `CAPABILITY_IAM` or `CAPABILITY_NAMED_IAM`.").
+		Build()
+	attributes["disable_rollback"] = util.ResourceBool().
+		Optional().
+		Description("Whether to keep the resources of the stack when it fails to deploy, instead of rolling them back.").
+		Build()
+	attributes["tags"] = util.ResourceMap(types.StringType).
+		Optional().
+		Description("The tags applied to the stack.").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deploys an AWS CloudFormation template.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+			Validators: []validator.Object{templateSourceValidator{}},
+		},
+	}
+}
+
+// getApplyAwsCloudFormationChangeSetActionBlock returns the block of actions that apply an AWS CloudFormation change
+// set (Octopus.AwsApplyCloudFormationChangeSet).
+func getApplyAwsCloudFormationChangeSetActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getAwsCloudFormationActionAttributes()
+	attributes["change_set_name"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name or ARN of the change set to apply.").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that applies an AWS CloudFormation change set.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// getDeleteAwsCloudFormationStackActionBlock returns the block of actions that delete an AWS CloudFormation stack
+// (Octopus.AwsDeleteCloudFormation).
+func getDeleteAwsCloudFormationStackActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getAwsCloudFormationActionAttributes()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deletes an AWS CloudFormation stack.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// getAwsCloudFormationActionAttributes returns the attributes and blocks shared by the actions that manage an AWS
+// CloudFormation stack.
+func getAwsCloudFormationActionAttributes() (map[string]resourceSchema.Attribute, map[string]resourceSchema.Block) {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	attributes["stack_name"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name of the CloudFormation stack.").
+		Build()
+	attributes["wait_for_completion"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(true).
+		Description("Whether the action waits for the stack to finish changing before it completes.").
+		Build()
+	blocks["aws_account"] = getAwsAccountBlock()
+	delete(blocks, "package")
+	return attributes, blocks
+}
+
+// getAwsAccountBlock returns the required AWS account of an action, which is the aws_account block of the Terraform
+// template action with a required region.
+func getAwsAccountBlock() resourceSchema.SetNestedBlock {
+	block := getTerraformTemplateAwsAccountBlock()
+	block.Description = "The AWS account the action uses."
+	block.NestedObject.Attributes["region"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The AWS region the action runs against.").
+		Build()
+	block.NestedObject.Attributes["variable"] = util.ResourceString().
+		Optional().
+		Description("The name of the project variable that references the AWS account. Required unless `use_instance_role` is enabled.").
+		Build()
+	block.NestedObject.Attributes["use_instance_role"] = util.ResourceBool().
+		Optional().
+		Description("Whether to use the credentials of the AWS instance role of the worker, instead of an AWS account.").
+		Build()
+	block.NestedObject.Validators = []validator.Object{awsAccountVariableValidator{}}
+	block.Validators = append(block.Validators, setvalidator.IsRequired())
+	return block
+}
+
+// addTemplateSourceAttributes adds the attributes and blocks of an action whose template is either inline or in a
+// package.
+func addTemplateSourceAttributes(attributes map[string]resourceSchema.Attribute, blocks map[string]resourceSchema.Block) {
+	attributes["inline_template"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The template, when it is not sourced from a package.").
+		Build()
+	attributes["parameters"] = util.ResourceMap(types.StringType).
+		Optional().
+		Description("The values of the parameters of the inline template.").
+		Build()
+	attributes["parameters_file_path"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The path of the parameters file in the package, relative to the root of the package.").
+		Build()
+	attributes["template_path"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The path of the template in the package, relative to the root of the package.").
+		Build()
+	blocks["primary_package"] = getPrimaryPackageBlock(false)
+}
+
+// TemplateSourceModel holds the attributes of an action whose template is either inline or in a package.
+type TemplateSourceModel struct {
+	InlineTemplate     types.String            `tfsdk:"inline_template"`
+	Parameters         types.Map               `tfsdk:"parameters"`
+	ParametersFilePath types.String            `tfsdk:"parameters_file_path"`
+	TemplatePath       types.String            `tfsdk:"template_path"`
+	PrimaryPackage     []PackageReferenceModel `tfsdk:"primary_package"`
+}
+
+// AwsCloudFormationActionModel holds the attributes shared by the actions that manage an AWS CloudFormation stack.
+type AwsCloudFormationActionModel struct {
+	StackName         types.String                       `tfsdk:"stack_name"`
+	WaitForCompletion types.Bool                         `tfsdk:"wait_for_completion"`
+	AwsAccount        []TerraformTemplateAwsAccountModel `tfsdk:"aws_account"`
+}
+
+type DeployAwsCloudFormationTemplateActionModel struct {
+	Capabilities    types.List `tfsdk:"capabilities"`
+	DisableRollback types.Bool `tfsdk:"disable_rollback"`
+	Tags            types.Map  `tfsdk:"tags"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	AwsCloudFormationActionModel
+	TemplateSourceModel
+}
+
+type ApplyAwsCloudFormationChangeSetActionModel struct {
+	ChangeSetName types.String `tfsdk:"change_set_name"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	AwsCloudFormationActionModel
+}
+
+type DeleteAwsCloudFormationStackActionModel struct {
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	AwsCloudFormationActionModel
+}
+
+// awsAccountVariableValidator requires an AWS account to reference the variable of an account, unless it uses the
+// instance role of the worker.
+type awsAccountVariableValidator struct{}
+
+func (v awsAccountVariableValidator) Description(ctx context.Context) string {
+	return "validates that the account variable is set unless the instance role is used"
+}
+
+func (v awsAccountVariableValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v awsAccountVariableValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	variable, _ := attributes["variable"].(types.String)
+	useInstanceRole, _ := attributes["use_instance_role"].(types.Bool)
+	if variable.IsUnknown() || useInstanceRole.IsUnknown() || useInstanceRole.ValueBool() {
+		return
+	}
+
+	if variable.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("variable"),
+			"Missing AWS account variable",
+			"The variable that references the AWS account must be set, unless use_instance_role is enabled.",
+		)
+	}
+}
+
+// templateSourceValidator requires an action to take its template from either inline_template or a package, and to
+// only set the attributes of that source.
+type templateSourceValidator struct{}
+
+func (v templateSourceValidator) Description(ctx context.Context) string {
+	return "validates that the template is either inline or in a package"
+}
+
+func (v templateSourceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v templateSourceValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	for _, name := range []string{"inline_template", "primary_package"} {
+		if attributes[name] == nil || attributes[name].IsUnknown() {
+			return
+		}
+	}
+
+	inline := isConfiguredManifestSource(attributes["inline_template"])
+	fromPackage := isConfiguredManifestSource(attributes["primary_package"])
+	if inline == fromPackage {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid template source",
+			"The template must be set by exactly one of inline_template and primary_package.",
+		)
+		return
+	}
+
+	unused, source := []string{"parameters_file_path", "template_path"}, "inline"
+	if fromPackage {
+		unused, source = []string{"parameters"}, "in a package"
+		if templatePath := attributes["template_path"]; templatePath != nil && templatePath.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("template_path"),
+				"Missing template path",
+				"template_path must be set when the template is in a package.",
+			)
+		}
+	}
+	for _, name := range unused {
+		if isConfiguredAttribute(attributes[name]) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(name),
+				"Invalid template attribute",
+				fmt.Sprintf("%s cannot be set when the template is %s.", name, source),
+			)
+		}
+	}
+}
+
+func isConfiguredAttribute(value attr.Value) bool {
+	return value != nil && !value.IsNull() && !value.IsUnknown()
+}
@@ -0,0 +1,82 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAwsAccountVariableValidator(t *testing.T) {
+	validate := func(variable types.String, useInstanceRole types.Bool) diag.Diagnostics {
+		value := types.ObjectValueMust(
+			map[string]attr.Type{"variable": types.StringType, "use_instance_role": types.BoolType},
+			map[string]attr.Value{"variable": variable, "use_instance_role": useInstanceRole},
+		)
+		resp := &validator.ObjectResponse{}
+		awsAccountVariableValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{ConfigValue: value}, resp)
+		return resp.Diagnostics
+	}
+
+	require.Empty(t, validate(types.StringValue("AWS.Account"), types.BoolNull()))
+	require.Empty(t, validate(types.StringNull(), types.BoolValue(true)))
+	require.Empty(t, validate(types.StringUnknown(), types.BoolNull()))
+	require.True(t, validate(types.StringNull(), types.BoolNull()).HasError())
+	require.True(t, validate(types.StringValue(""), types.BoolValue(false)).HasError())
+}
+
+func TestTemplateSourceValidator(t *testing.T) {
+	packageType := types.ObjectType{AttrTypes: map[string]attr.Type{"package_id": types.StringType}}
+	packages := func(ids ...string) types.List {
+		elements := []attr.Value{}
+		for _, id := range ids {
+			elements = append(elements, types.ObjectValueMust(packageType.AttrTypes, map[string]attr.Value{"package_id": types.StringValue(id)}))
+		}
+		return types.ListValueMust(packageType, elements)
+	}
+	parameters := func(values map[string]string) types.Map {
+		if values == nil {
+			return types.MapNull(types.StringType)
+		}
+		elements := map[string]attr.Value{}
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+	validate := func(inline types.String, params types.Map, templatePath types.String, primaryPackage types.List) diag.Diagnostics {
+		value := types.ObjectValueMust(
+			map[string]attr.Type{
+				"inline_template":      types.StringType,
+				"parameters":           types.MapType{ElemType: types.StringType},
+				"parameters_file_path": types.StringType,
+				"template_path":        types.StringType,
+				"primary_package":      primaryPackage.Type(context.Background()),
+			},
+			map[string]attr.Value{
+				"inline_template":      inline,
+				"parameters":           params,
+				"parameters_file_path": types.StringNull(),
+				"template_path":        templatePath,
+				"primary_package":      primaryPackage,
+			},
+		)
+		resp := &validator.ObjectResponse{}
+		templateSourceValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{ConfigValue: value}, resp)
+		return resp.Diagnostics
+	}
+
+	template := types.StringValue("Resources: {}")
+	require.Empty(t, validate(template, parameters(map[string]string{"Environment": "test"}), types.StringNull(), packages()))
+	require.Empty(t, validate(types.StringNull(), parameters(nil), types.StringValue("template.yaml"), packages("stack")))
+	require.Empty(t, validate(types.StringUnknown(), parameters(nil), types.StringNull(), packages()))
+	require.True(t, validate(types.StringNull(), parameters(nil), types.StringNull(), packages()).HasError())
+	require.True(t, validate(template, parameters(nil), types.StringNull(), packages("stack")).HasError())
+	require.True(t, validate(template, parameters(nil), types.StringValue("template.yaml"), packages()).HasError())
+	require.True(t, validate(types.StringNull(), parameters(map[string]string{"Environment": "test"}), types.StringValue("template.yaml"), packages("stack")).HasError())
+	require.True(t, validate(types.StringNull(), parameters(nil), types.StringNull(), packages("stack")).HasError())
+}
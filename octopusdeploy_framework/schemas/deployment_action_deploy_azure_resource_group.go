@@ -0,0 +1,65 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployAzureResourceGroupActionBlock returns the block of actions that deploy an Azure Resource Manager template to
+// a resource group (Octopus.AzureResourceGroup).
+func getDeployAzureResourceGroupActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	addTemplateSourceAttributes(attributes, blocks)
+	attributes["deployment_mode"] = util.ResourceString().
+		Optional().
+		Computed().
+		Default("Incremental").
+		Validators(stringvalidator.OneOf("Complete", "Incremental")).
+		Description("The mode of the resource group deployment: `Complete` or `Incremental`.").
+		Build()
+	attributes["resource_group_name"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name of the resource group the template is deployed to.").
+		Build()
+	blocks["azure_account"] = getAzureAccountBlock()
+	delete(blocks, "package")
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deploys an Azure Resource Manager template to a resource group.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+			Validators: []validator.Object{templateSourceValidator{}},
+		},
+	}
+}
+
+// getAzureAccountBlock returns the required Azure account of an action, which is the azure_account block of the
+// Terraform template action with a required variable.
+func getAzureAccountBlock() resourceSchema.SetNestedBlock {
+	block := getTerraformTemplateAzureAccountBlock()
+	block.Description = "The Azure account the action uses."
+	block.NestedObject.Attributes["variable"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name of the project variable that references the Azure account.").
+		Build()
+	block.Validators = append(block.Validators, setvalidator.IsRequired())
+	return block
+}
+
+type DeployAzureResourceGroupActionModel struct {
+	DeploymentMode    types.String                         `tfsdk:"deployment_mode"`
+	ResourceGroupName types.String                         `tfsdk:"resource_group_name"`
+	AzureAccount      []TerraformTemplateAzureAccountModel `tfsdk:"azure_account"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	TemplateSourceModel
+}
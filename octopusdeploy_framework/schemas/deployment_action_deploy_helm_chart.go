@@ -0,0 +1,96 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployHelmChartActionBlock returns the block of actions that upgrade a Helm chart (Octopus.HelmChartUpgrade).
+func getDeployHelmChartActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	attributes["release_name"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name of the Helm release.").
+		Build()
+	attributes["namespace"] = util.ResourceString().
+		Optional().
+		Description("The Kubernetes namespace the release is installed in.").
+		Build()
+	attributes["yaml_values"] = util.ResourceString().
+		Optional().
+		Description("Inline YAML values that are applied to the chart.").
+		Build()
+	attributes["key_values"] = util.ResourceMap(types.StringType).
+		Optional().
+		Description("Explicit values that are applied to the chart, overriding the values from the values files and `yaml_values`.").
+		Build()
+	attributes["reset_values"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(true).
+		Description("Whether to reset the values of the release to the values of the chart, ignoring the values of the previous release.").
+		Build()
+	attributes["timeout"] = util.ResourceString().
+		Optional().
+		Description("How long to wait for the upgrade to complete, as a duration such as `5m0s`.").
+		Build()
+	attributes["helm_client_version"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.OneOf("V2", "V3")).
+		Description("The version of the Helm client used for the upgrade: `V2` or `V3`.").
+		Build()
+	blocks["primary_package"] = getPrimaryPackageBlock(true)
+	blocks["values_package"] = getHelmValuesPackageBlock()
+	delete(blocks, "package")
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that upgrades a Helm chart.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// getHelmValuesPackageBlock returns the block of packages that hold values files applied to a Helm chart.
+func getHelmValuesPackageBlock() resourceSchema.ListNestedBlock {
+	block := getPackageReferenceBlock()
+	block.Description = "A package that holds a values file applied to the chart."
+	block.NestedObject.Attributes["name"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The name of the package reference.").
+		Build()
+	block.NestedObject.Attributes["values_file_path"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The path of the values file, relative to the root of the package.").
+		Build()
+	return block
+}
+
+type DeployHelmChartActionModel struct {
+	HelmClientVersion types.String             `tfsdk:"helm_client_version"`
+	KeyValues         types.Map                `tfsdk:"key_values"`
+	Namespace         types.String             `tfsdk:"namespace"`
+	ReleaseName       types.String             `tfsdk:"release_name"`
+	ResetValues       types.Bool               `tfsdk:"reset_values"`
+	Timeout           types.String             `tfsdk:"timeout"`
+	YamlValues        types.String             `tfsdk:"yaml_values"`
+	PrimaryPackage    []PackageReferenceModel  `tfsdk:"primary_package"`
+	ValuesPackages    []HelmValuesPackageModel `tfsdk:"values_package"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+}
+
+// HelmValuesPackageModel is a package that holds values files applied to a Helm chart.
+type HelmValuesPackageModel struct {
+	ValuesFilePath types.String `tfsdk:"values_file_path"`
+
+	PackageReferenceModel
+}
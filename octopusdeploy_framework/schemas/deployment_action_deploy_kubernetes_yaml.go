@@ -0,0 +1,162 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployKubernetesYamlActionBlock returns the block of actions that apply Kubernetes YAML manifests
+// (Octopus.KubernetesDeployRawYaml).
+func getDeployKubernetesYamlActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addKubernetesManifestAttributes(attributes, blocks)
+	attributes["inline_yaml"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The YAML manifests to apply, when they are not sourced from a package or a git repository.").
+		Build()
+	attributes["namespace"] = util.ResourceString().
+		Optional().
+		Description("The Kubernetes namespace the resources are applied to, when their manifests do not specify one.").
+		Build()
+	attributes["yaml_file_paths"] = util.ResourceList(types.StringType).
+		Optional().
+		Validators(listvalidator.SizeAtLeast(1)).
+		Description("The paths of the YAML files to apply, relative to the root of the package or git repository. Extended wildcard syntax is supported.").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that applies Kubernetes YAML manifests.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+			Validators: []validator.Object{kubernetesManifestSourceValidator{inlineAttribute: "inline_yaml"}},
+		},
+	}
+}
+
+// addKubernetesManifestAttributes adds the attributes and blocks of an action that applies Kubernetes manifests from a
+// package or a git repository.
+func addKubernetesManifestAttributes(attributes map[string]resourceSchema.Attribute, blocks map[string]resourceSchema.Block) {
+	addActionExecutionAttributes(attributes)
+	attributes["kubernetes_object_status_check_enabled"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(true).
+		Description("Whether to wait for the Kubernetes resources to become ready before the action completes.").
+		Build()
+	attributes["kubernetes_object_status_timeout"] = util.ResourceInt64().
+		Optional().
+		Validators(int64validator.AtLeast(1)).
+		Description("How long, in seconds, to wait for the Kubernetes resources to become ready.").
+		Build()
+	attributes["kubernetes_object_status_wait_for_jobs"] = util.ResourceBool().
+		Optional().
+		Description("Whether to wait for jobs to complete when waiting for the Kubernetes resources to become ready.").
+		Build()
+	attributes["server_side_apply_enabled"] = util.ResourceBool().
+		Optional().
+		Description("Whether the resources are applied with server-side apply.").
+		Build()
+	attributes["server_side_apply_force_conflicts"] = util.ResourceBool().
+		Optional().
+		Description("Whether server-side apply takes ownership of fields managed by other field managers.").
+		Build()
+	attributes["structured_variable_substitution_files"] = util.ResourceList(types.StringType).
+		Optional().
+		Validators(listvalidator.SizeAtLeast(1)).
+		Description("The files in which to replace structured configuration values with variables, relative to the root of the package or git repository. Extended wildcard syntax is supported.").
+		Build()
+	blocks["primary_package"] = getPrimaryPackageBlock(false)
+	delete(blocks, "package")
+}
+
+// KubernetesManifestActionModel holds the attributes of an action that applies Kubernetes manifests.
+type KubernetesManifestActionModel struct {
+	KubernetesObjectStatusCheckEnabled  types.Bool              `tfsdk:"kubernetes_object_status_check_enabled"`
+	KubernetesObjectStatusTimeout       types.Int64             `tfsdk:"kubernetes_object_status_timeout"`
+	KubernetesObjectStatusWaitForJobs   types.Bool              `tfsdk:"kubernetes_object_status_wait_for_jobs"`
+	ServerSideApplyEnabled              types.Bool              `tfsdk:"server_side_apply_enabled"`
+	ServerSideApplyForceConflicts       types.Bool              `tfsdk:"server_side_apply_force_conflicts"`
+	StructuredVariableSubstitutionFiles types.List              `tfsdk:"structured_variable_substitution_files"`
+	PrimaryPackage                      []PackageReferenceModel `tfsdk:"primary_package"`
+}
+
+type DeployKubernetesYamlActionModel struct {
+	InlineYaml    types.String `tfsdk:"inline_yaml"`
+	Namespace     types.String `tfsdk:"namespace"`
+	YamlFilePaths types.List   `tfsdk:"yaml_file_paths"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	KubernetesManifestActionModel
+}
+
+// kubernetesManifestSourceValidator requires an action to take its manifests from exactly one source: a package, a git
+// repository, or the inline attribute of action blocks that have one.
+type kubernetesManifestSourceValidator struct {
+	inlineAttribute string
+}
+
+func (v kubernetesManifestSourceValidator) Description(ctx context.Context) string {
+	return "validates that the manifests are sourced from exactly one of " + strings.Join(v.sources(), ", ")
+}
+
+func (v kubernetesManifestSourceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v kubernetesManifestSourceValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var configured []string
+	attributes := req.ConfigValue.Attributes()
+	for _, source := range v.sources() {
+		value := attributes[source]
+		if value == nil || value.IsUnknown() {
+			return
+		}
+		if isConfiguredManifestSource(value) {
+			configured = append(configured, source)
+		}
+	}
+
+	if len(configured) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid manifest source",
+			fmt.Sprintf("The manifests must be sourced from exactly one of %s, but %d are configured.", strings.Join(v.sources(), ", "), len(configured)),
+		)
+	}
+}
+
+func (v kubernetesManifestSourceValidator) sources() []string {
+	sources := []string{"primary_package", "git_dependency"}
+	if v.inlineAttribute != "" {
+		sources = append([]string{v.inlineAttribute}, sources...)
+	}
+	return sources
+}
+
+// isConfiguredManifestSource reports whether an attribute is set, or a block has an element.
+func isConfiguredManifestSource(value attr.Value) bool {
+	switch v := value.(type) {
+	case types.List:
+		return len(v.Elements()) > 0
+	case types.Set:
+		return len(v.Elements()) > 0
+	}
+	return !value.IsNull()
+}
@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesManifestSourceValidator(t *testing.T) {
+	packageType := types.ObjectType{AttrTypes: map[string]attr.Type{"package_id": types.StringType}}
+	dependencyType := types.ObjectType{AttrTypes: map[string]attr.Type{"repository_uri": types.StringType}}
+	packages := func(ids ...string) types.List {
+		elements := []attr.Value{}
+		for _, id := range ids {
+			elements = append(elements, types.ObjectValueMust(packageType.AttrTypes, map[string]attr.Value{"package_id": types.StringValue(id)}))
+		}
+		return types.ListValueMust(packageType, elements)
+	}
+	dependencies := func(uris ...string) types.Set {
+		elements := []attr.Value{}
+		for _, uri := range uris {
+			elements = append(elements, types.ObjectValueMust(dependencyType.AttrTypes, map[string]attr.Value{"repository_uri": types.StringValue(uri)}))
+		}
+		return types.SetValueMust(dependencyType, elements)
+	}
+	validate := func(v kubernetesManifestSourceValidator, inline types.String, primaryPackage types.List, gitDependency types.Set) diag.Diagnostics {
+		attributes := map[string]attr.Value{"primary_package": primaryPackage, "git_dependency": gitDependency}
+		attributeTypes := map[string]attr.Type{"primary_package": primaryPackage.Type(context.Background()), "git_dependency": gitDependency.Type(context.Background())}
+		if v.inlineAttribute != "" {
+			attributes[v.inlineAttribute] = inline
+			attributeTypes[v.inlineAttribute] = types.StringType
+		}
+
+		resp := &validator.ObjectResponse{}
+		v.ValidateObject(context.Background(), validator.ObjectRequest{ConfigValue: types.ObjectValueMust(attributeTypes, attributes)}, resp)
+		return resp.Diagnostics
+	}
+
+	yaml := kubernetesManifestSourceValidator{inlineAttribute: "inline_yaml"}
+	require.Empty(t, validate(yaml, types.StringValue("kind: ConfigMap"), packages(), dependencies()))
+	require.Empty(t, validate(yaml, types.StringNull(), packages("manifests"), dependencies()))
+	require.Empty(t, validate(yaml, types.StringNull(), packages(), dependencies("https://example.com/web.git")))
+	require.Empty(t, validate(yaml, types.StringUnknown(), packages("manifests"), dependencies()))
+	require.True(t, validate(yaml, types.StringNull(), packages(), dependencies()).HasError())
+	require.True(t, validate(yaml, types.StringValue("kind: ConfigMap"), packages("manifests"), dependencies()).HasError())
+
+	kustomize := kubernetesManifestSourceValidator{}
+	require.Empty(t, validate(kustomize, types.StringNull(), packages("manifests"), dependencies()))
+	require.True(t, validate(kustomize, types.StringNull(), packages("manifests"), dependencies("https://example.com/web.git")).HasError())
+	require.True(t, validate(kustomize, types.StringNull(), packages(), dependencies()).HasError())
+}
@@ -0,0 +1,37 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployKustomizeActionBlock returns the block of actions that apply a Kustomize overlay (Octopus.Kustomize).
+func getDeployKustomizeActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addKubernetesManifestAttributes(attributes, blocks)
+	attributes["overlay_path"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The path of the directory that holds the kustomization file of the overlay, relative to the root of the package or git repository.").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that applies a Kustomize overlay.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+			Validators: []validator.Object{kubernetesManifestSourceValidator{}},
+		},
+	}
+}
+
+type DeployKustomizeActionModel struct {
+	OverlayPath types.String `tfsdk:"overlay_path"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	KubernetesManifestActionModel
+}
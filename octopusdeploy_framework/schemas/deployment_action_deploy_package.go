@@ -0,0 +1,37 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// getDeployPackageActionBlock returns the block of actions that deploy a package to a tentacle
+// (Octopus.TentaclePackage).
+func getDeployPackageActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	blocks["primary_package"] = getPrimaryPackageBlock(true)
+	blocks["windows_service"] = resourceSchema.SetNestedBlock{
+		Description: "Deploy a windows service feature",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: getWindowsServiceAttributes(),
+		},
+		Validators: []validator.Set{setvalidator.SizeAtMost(1)},
+	}
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deploys a package.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+type DeployPackageActionModel struct {
+	PrimaryPackage []PackageReferenceModel `tfsdk:"primary_package"`
+	Packages       []PackageReferenceModel `tfsdk:"package"`
+	WindowsService []WindowsServiceModel   `tfsdk:"windows_service"`
+
+	DeploymentActionBaseModel
+}
@@ -0,0 +1,104 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployWindowsServiceActionBlock returns the block of actions that deploy a package as a Windows service
+// (Octopus.WindowsService).
+func getDeployWindowsServiceActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	for name, attribute := range getWindowsServiceAttributes() {
+		attributes[name] = attribute
+	}
+	blocks["primary_package"] = getPrimaryPackageBlock(true)
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deploys a package as a Windows service.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+func getWindowsServiceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"arguments": util.ResourceString().
+			Optional().
+			Description("The command line arguments that will be passed to the service when it starts").
+			Build(),
+		"create_or_update_service": util.ResourceBool().
+			Optional().
+			Computed().
+			Build(),
+		"custom_account_name": util.ResourceString().
+			Optional().
+			Description("The Windows/domain account of the custom user that the service will run under").
+			Build(),
+		"custom_account_password": util.ResourceString().
+			Optional().
+			Computed().
+			Sensitive().
+			Description("The password for the custom account").
+			Build(),
+		"dependencies": util.ResourceString().
+			Optional().
+			Description("Any dependencies that the service has. Separate the names using forward slashes (/).").
+			Build(),
+		"description": util.ResourceString().
+			Optional().
+			Description("User-friendly description of the service (optional)").
+			Build(),
+		"display_name": util.ResourceString().
+			Optional().
+			Description("The display name of the service (optional)").
+			Build(),
+		"executable_path": util.ResourceString().
+			Required().
+			Description("The path to the executable relative to the package installation directory").
+			Build(),
+		"service_account": util.ResourceString().
+			Optional().
+			Computed().
+			Default("LocalSystem").
+			Description("Which built-in account will the service run under. Can be LocalSystem, NT Authority\\NetworkService, NT Authority\\LocalService, _CUSTOM or an expression").
+			Build(),
+		"service_name": util.ResourceString().
+			Required().
+			Description("The name of the service").
+			Build(),
+		"start_mode": util.ResourceString().
+			Optional().
+			Computed().
+			Default("auto").
+			Description("When will the service start. Can be auto, delayed-auto, manual, unchanged or an expression").
+			Build(),
+	}
+}
+
+// WindowsServiceModel holds the attributes of a Windows service, either of a deploy_windows_service_action block or
+// of the windows_service feature of a deploy_package_action block.
+type WindowsServiceModel struct {
+	Arguments             types.String `tfsdk:"arguments"`
+	CreateOrUpdateService types.Bool   `tfsdk:"create_or_update_service"`
+	CustomAccountName     types.String `tfsdk:"custom_account_name"`
+	CustomAccountPassword types.String `tfsdk:"custom_account_password"`
+	Dependencies          types.String `tfsdk:"dependencies"`
+	Description           types.String `tfsdk:"description"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	ExecutablePath        types.String `tfsdk:"executable_path"`
+	ServiceAccount        types.String `tfsdk:"service_account"`
+	ServiceName           types.String `tfsdk:"service_name"`
+	StartMode             types.String `tfsdk:"start_mode"`
+}
+
+type DeployWindowsServiceActionModel struct {
+	PrimaryPackage []PackageReferenceModel `tfsdk:"primary_package"`
+	Packages       []PackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+	WindowsServiceModel
+}
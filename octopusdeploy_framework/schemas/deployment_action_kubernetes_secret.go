@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeployKubernetesSecretActionBlock returns the block of actions that deploy a Kubernetes secret
+// (Octopus.KubernetesDeploySecret).
+func getDeployKubernetesSecretActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	attributes["secret_name"] = util.ResourceString().
+		Required().
+		Description("The name of the secret resource").
+		Build()
+	attributes["secret_values"] = util.ResourceMap(types.StringType).
+		Required().
+		Description("The keys and values of the secret.").
+		Build()
+	attributes["kubernetes_object_status_check_enabled"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(true).
+		Description("Indicates the status of the Kubernetes Object Status feature").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that deploys a Kubernetes secret.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+type DeployKubernetesSecretActionModel struct {
+	SecretName                         types.String            `tfsdk:"secret_name"`
+	SecretValues                       types.Map               `tfsdk:"secret_values"`
+	KubernetesObjectStatusCheckEnabled types.Bool              `tfsdk:"kubernetes_object_status_check_enabled"`
+	Packages                           []PackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+}
@@ -0,0 +1,41 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getManualInterventionActionBlock returns the block of actions that wait for a user to act (Octopus.Manual).
+func getManualInterventionActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	attributes["instructions"] = util.ResourceString().
+		Required().
+		Description("The instructions for the user to follow").
+		Build()
+	attributes["responsible_teams"] = util.ResourceString().
+		Optional().
+		Description("The teams responsible to resolve this step. If no teams are specified, all users who have permission to deploy the project can resolve it.").
+		Build()
+	attributes["block_deployments"] = util.ResourceString().
+		Optional().
+		Description("Should other deployments be blocked while this manual intervention is awaiting action.").
+		Build()
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that pauses the deployment until a user approves or rejects it.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+type ManualInterventionActionModel struct {
+	Instructions     types.String            `tfsdk:"instructions"`
+	ResponsibleTeams types.String            `tfsdk:"responsible_teams"`
+	BlockDeployments types.String            `tfsdk:"block_deployments"`
+	Packages         []PackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+}
@@ -0,0 +1,38 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getRunKubectlScriptActionBlock returns the block of actions that run a script against a Kubernetes cluster
+// (Octopus.KubernetesRunScript).
+func getRunKubectlScriptActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	addActionExecutionAttributes(attributes)
+	addScriptActionAttributes(attributes)
+	attributes["namespace"] = util.ResourceString().
+		Optional().
+		Description("The Kubernetes namespace the script runs in.").
+		Build()
+	addActionPackageBlocks(blocks)
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that runs a script with kubectl configured for a Kubernetes cluster.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+type RunKubectlScriptActionModel struct {
+	Namespace      types.String                 `tfsdk:"namespace"`
+	PrimaryPackage []PackageReferenceModel      `tfsdk:"primary_package"`
+	Packages       []NamedPackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	ScriptActionModel
+}
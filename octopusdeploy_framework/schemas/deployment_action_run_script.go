@@ -0,0 +1,75 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getRunScriptActionBlock returns the block of actions that run a script (Octopus.Script).
+func getRunScriptActionBlock() resourceSchema.ListNestedBlock {
+	attributes, blocks := getActionAttributes()
+	properties := attributes["properties"].(resourceSchema.MapAttribute)
+	properties.DeprecationMessage = deprecatedActionPropertiesMessage
+	attributes["properties"] = properties
+	addActionExecutionAttributes(attributes)
+	addScriptActionAttributes(attributes)
+	addActionPackageBlocks(blocks)
+
+	return resourceSchema.ListNestedBlock{
+		Description: "An action that runs a script.",
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+}
+
+// addScriptActionAttributes adds the attributes of an action that runs an inline script or a script from a package.
+func addScriptActionAttributes(attributes map[string]resourceSchema.Attribute) {
+	attributes["script_body"] = util.ResourceString().
+		Optional().
+		Description("The script to run when the script source is `Inline`.").
+		Build()
+	attributes["script_file_name"] = util.ResourceString().
+		Optional().
+		Description("The script file name in the package").
+		Build()
+	attributes["script_parameters"] = util.ResourceString().
+		Optional().
+		Description("Parameters expected by the script. Use platform specific calling convention. e.g. -Path #{VariableStoringPath} for PowerShell or -- #{VariableStoringPath} for ScriptCS").
+		Build()
+	attributes["script_source"] = util.ResourceString().
+		Optional().
+		Computed().
+		Description("Where the script comes from: `Inline` or `Package`.").
+		Build()
+	attributes["script_syntax"] = util.ResourceString().
+		Optional().
+		Computed().
+		Description("The syntax of the inline script, such as `PowerShell` or `Bash`.").
+		Build()
+	attributes["variable_substitution_in_files"] = util.ResourceString().
+		Optional().
+		Description("A newline-separated list of file names to transform, relative to the package contents. Extended wildcard syntax is supported.").
+		Build()
+}
+
+// ScriptActionModel holds the attributes of an action that runs a script.
+type ScriptActionModel struct {
+	ScriptBody                  types.String `tfsdk:"script_body"`
+	ScriptFileName              types.String `tfsdk:"script_file_name"`
+	ScriptParameters            types.String `tfsdk:"script_parameters"`
+	ScriptSource                types.String `tfsdk:"script_source"`
+	ScriptSyntax                types.String `tfsdk:"script_syntax"`
+	VariableSubstitutionInFiles types.String `tfsdk:"variable_substitution_in_files"`
+}
+
+type RunScriptActionModel struct {
+	PrimaryPackage []PackageReferenceModel      `tfsdk:"primary_package"`
+	Packages       []NamedPackageReferenceModel `tfsdk:"package"`
+
+	DeploymentActionBaseModel
+	DeploymentActionExecutionModel
+	ScriptActionModel
+}
@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const DeploymentProcessResourceDescription = "deployment_process"
+
+// DeploymentProcessSchemaVersion is the version of the deployment and runbook process schemas. Version 0 is the
+// layout written by the SDK implementation of the resources.
+const DeploymentProcessSchemaVersion = 1
+
+type DeploymentProcessSchema struct{}
+
+var _ EntitySchema = DeploymentProcessSchema{}
+
+func (d DeploymentProcessSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "This resource manages deployment processes in Octopus Deploy.",
+		Version:     DeploymentProcessSchemaVersion,
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"branch": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Description("The branch name associated with this deployment process (i.e. `main`). This value is optional and only applies to associated projects that are stored in version control.").
+				Build(),
+			"last_snapshot_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Build(),
+			"project_id": util.ResourceString().
+				Required().
+				Description("The project ID associated with this deployment process.").
+				Build(),
+			"space_id": GetSpaceIdResourceSchema("deployment process"),
+			"version": util.ResourceInt64().
+				Optional().
+				Computed().
+				Description("The version number of this deployment process.").
+				Build(),
+		},
+		Blocks: map[string]resourceSchema.Block{
+			"step": getDeploymentStepBlock(),
+		},
+	}
+}
+
+func (d DeploymentProcessSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type DeploymentProcessResourceModel struct {
+	Branch         types.String          `tfsdk:"branch"`
+	LastSnapshotID types.String          `tfsdk:"last_snapshot_id"`
+	ProjectID      types.String          `tfsdk:"project_id"`
+	SpaceID        types.String          `tfsdk:"space_id"`
+	Steps          []DeploymentStepModel `tfsdk:"step"`
+	Version        types.Int64           `tfsdk:"version"`
+
+	ResourceModel
+}
@@ -0,0 +1,114 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getDeploymentStepBlock returns the step block shared by deployment and runbook processes.
+func getDeploymentStepBlock() resourceSchema.ListNestedBlock {
+	return resourceSchema.ListNestedBlock{
+		NestedObject: keepZeroValuesFromState(resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"condition": util.ResourceString().
+					Optional().
+					Computed().
+					Default("Success").
+					Validators(stringvalidator.OneOf("Always", "Failure", "Success", "Variable")).
+					Description("When to run the step, one of 'Success', 'Failure', 'Always' or 'Variable'").
+					Build(),
+				"condition_expression": util.ResourceString().
+					Optional().
+					Computed().
+					Validators(NewOctostacheValidator()).
+					Description("The expression to evaluate to determine whether to run this step when 'condition' is 'Variable'").
+					Build(),
+				"id": util.ResourceString().
+					Optional().
+					Computed().
+					Description("The unique ID for this resource.").
+					Build(),
+				"name": util.ResourceString().
+					Required().
+					Validators(stringvalidator.LengthAtLeast(1)).
+					Description("The name of this resource.").
+					Build(),
+				"package_requirement": util.ResourceString().
+					Optional().
+					Computed().
+					Default("LetOctopusDecide").
+					Validators(stringvalidator.OneOf("AfterPackageAcquisition", "BeforePackageAcquisition", "LetOctopusDecide")).
+					Description("Whether to run this step before or after package acquisition (if possible)").
+					Build(),
+				"properties": util.ResourceMap(types.StringType).
+					Optional().
+					Computed().
+					Validators(NewOctostachePropertiesValidator()).
+					Build(),
+				"start_trigger": util.ResourceString().
+					Optional().
+					Computed().
+					Default("StartAfterPrevious").
+					Validators(stringvalidator.OneOf("StartAfterPrevious", "StartWithPrevious")).
+					Description("Whether to run this step after the previous step ('StartAfterPrevious') or at the same time as the previous step ('StartWithPrevious')").
+					Build(),
+				"target_roles": util.ResourceList(types.StringType).
+					Optional().
+					Computed().
+					Description("The roles that this step run against, or runs on behalf of").
+					Build(),
+				"window_size": util.ResourceString().
+					Optional().
+					Description("The maximum number of targets to deploy to simultaneously").
+					Build(),
+			},
+			Blocks: map[string]resourceSchema.Block{
+				"action": getDeploymentActionBlock(),
+				"apply_aws_cloudformation_change_set_action": getApplyAwsCloudFormationChangeSetActionBlock(),
+				"apply_terraform_template_action":            getApplyTerraformTemplateActionBlock(),
+				"delete_aws_cloudformation_stack_action":     getDeleteAwsCloudFormationStackActionBlock(),
+				"deploy_aws_cloudformation_template_action":  getDeployAwsCloudFormationTemplateActionBlock(),
+				"deploy_azure_resource_group_action":         getDeployAzureResourceGroupActionBlock(),
+				"deploy_helm_chart_action":                   getDeployHelmChartActionBlock(),
+				"deploy_kubernetes_secret_action":            getDeployKubernetesSecretActionBlock(),
+				"deploy_kubernetes_yaml_action":              getDeployKubernetesYamlActionBlock(),
+				"deploy_kustomize_action":                    getDeployKustomizeActionBlock(),
+				"deploy_package_action":                      getDeployPackageActionBlock(),
+				"deploy_windows_service_action":              getDeployWindowsServiceActionBlock(),
+				"manual_intervention_action":                 getManualInterventionActionBlock(),
+				"run_kubectl_script_action":                  getRunKubectlScriptActionBlock(),
+				"run_script_action":                          getRunScriptActionBlock(),
+			},
+		}),
+	}
+}
+
+type DeploymentStepModel struct {
+	Condition           types.String `tfsdk:"condition"`
+	ConditionExpression types.String `tfsdk:"condition_expression"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	PackageRequirement  types.String `tfsdk:"package_requirement"`
+	Properties          types.Map    `tfsdk:"properties"`
+	StartTrigger        types.String `tfsdk:"start_trigger"`
+	TargetRoles         types.List   `tfsdk:"target_roles"`
+	WindowSize          types.String `tfsdk:"window_size"`
+
+	Actions                                []DeploymentActionModel                      `tfsdk:"action"`
+	ApplyAwsCloudFormationChangeSetActions []ApplyAwsCloudFormationChangeSetActionModel `tfsdk:"apply_aws_cloudformation_change_set_action"`
+	ApplyTerraformTemplateActions          []ApplyTerraformTemplateActionModel          `tfsdk:"apply_terraform_template_action"`
+	DeleteAwsCloudFormationStackActions    []DeleteAwsCloudFormationStackActionModel    `tfsdk:"delete_aws_cloudformation_stack_action"`
+	DeployAwsCloudFormationTemplateActions []DeployAwsCloudFormationTemplateActionModel `tfsdk:"deploy_aws_cloudformation_template_action"`
+	DeployAzureResourceGroupActions        []DeployAzureResourceGroupActionModel        `tfsdk:"deploy_azure_resource_group_action"`
+	DeployHelmChartActions                 []DeployHelmChartActionModel                 `tfsdk:"deploy_helm_chart_action"`
+	DeployKubernetesSecretActions          []DeployKubernetesSecretActionModel          `tfsdk:"deploy_kubernetes_secret_action"`
+	DeployKubernetesYamlActions            []DeployKubernetesYamlActionModel            `tfsdk:"deploy_kubernetes_yaml_action"`
+	DeployKustomizeActions                 []DeployKustomizeActionModel                 `tfsdk:"deploy_kustomize_action"`
+	DeployPackageActions                   []DeployPackageActionModel                   `tfsdk:"deploy_package_action"`
+	DeployWindowsServiceActions            []DeployWindowsServiceActionModel            `tfsdk:"deploy_windows_service_action"`
+	ManualInterventionActions              []ManualInterventionActionModel              `tfsdk:"manual_intervention_action"`
+	RunKubectlScriptActions                []RunKubectlScriptActionModel                `tfsdk:"run_kubectl_script_action"`
+	RunScriptActions                       []RunScriptActionModel                       `tfsdk:"run_script_action"`
+}
@@ -13,7 +13,7 @@ type DockerContainerRegistryFeedSchema struct{}
 var _ EntitySchema = DockerContainerRegistryFeedSchema{}
 
 func (d DockerContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Attributes: map[string]resourceSchema.Attribute{
 			"api_version": resourceSchema.StringAttribute{
 				Optional: true,
@@ -31,6 +31,10 @@ func (d DockerContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Sc
 		},
 		Description: "This resource manages a Docker Container Registry in Octopus Deploy.",
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (d DockerContainerRegistryFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -48,4 +52,5 @@ type DockerContainerRegistryFeedTypeResourceModel struct {
 	RegistryPath                      types.String `tfsdk:"registry_path"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
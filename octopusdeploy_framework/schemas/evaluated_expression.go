@@ -0,0 +1,44 @@
+package schemas
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EvaluatedExpressionDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Template  types.String `tfsdk:"template"`
+	Variables types.Map    `tfsdk:"variables"`
+	Result    types.String `tfsdk:"result"`
+}
+
+type EvaluatedExpressionSchema struct{}
+
+var _ EntitySchema = EvaluatedExpressionSchema{}
+
+func (e EvaluatedExpressionSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (e EvaluatedExpressionSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Evaluates an Octostache template, such as a variable value or a step property, against a map of variables without connecting to the Octopus Server. Expressions that cannot be resolved are left in the result as written.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": GetIdDatasourceSchema(true),
+			"template": datasourceSchema.StringAttribute{
+				Description: "The Octostache template to evaluate.",
+				Required:    true,
+			},
+			"variables": datasourceSchema.MapAttribute{
+				Description: "The variables available to the template, keyed by name.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"result": datasourceSchema.StringAttribute{
+				Description: "The evaluated template.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -18,7 +18,7 @@ type GitCredentialSchema struct{}
 var _ EntitySchema = GitCredentialSchema{}
 
 func (g GitCredentialSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "Manages a Git credential in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"id":          GetIdResourceSchema(),
@@ -44,6 +44,10 @@ func (g GitCredentialSchema) GetResourceSchema() resourceSchema.Schema {
 				Build(),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (g GitCredentialSchema) GetDatasourceSchema() datasourceSchema.Schema {
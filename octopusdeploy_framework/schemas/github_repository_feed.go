@@ -13,7 +13,7 @@ type GitHubRepositoryFeedSchema struct{}
 var _ EntitySchema = GitHubRepositoryFeedSchema{}
 
 func (g GitHubRepositoryFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a GitHub repository feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"download_attempts":                    GetDownloadAttemptsResourceSchema(),
@@ -27,6 +27,10 @@ func (g GitHubRepositoryFeedSchema) GetResourceSchema() resourceSchema.Schema {
 			"username":                             GetUsernameResourceSchema(false),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (g GitHubRepositoryFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -44,4 +48,5 @@ type GitHubRepositoryFeedTypeResourceModel struct {
 	Username                          types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
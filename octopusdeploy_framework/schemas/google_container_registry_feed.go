@@ -12,7 +12,7 @@ type GoogleContainerRegistryFeedSchema struct{}
 var _ EntitySchema = GoogleContainerRegistryFeedSchema{}
 
 func (d GoogleContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a Google Container Registry feed in Octopus Deploy (alias of Docker Container Registry feed)",
 		Attributes: map[string]resourceSchema.Attribute{
 			"api_version": resourceSchema.StringAttribute{
@@ -41,6 +41,10 @@ func (d GoogleContainerRegistryFeedSchema) GetResourceSchema() resourceSchema.Sc
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (d GoogleContainerRegistryFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -58,6 +62,7 @@ type GoogleContainerRegistryFeedTypeResourceModel struct {
 	OidcAuthentication *GoogleContainerRegistryOidcAuthenticationResourceModel `tfsdk:"oidc_authentication"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
 
 type GoogleContainerRegistryOidcAuthenticationResourceModel struct {
@@ -17,7 +17,7 @@ func (h HelmFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
 var _ EntitySchema = HelmFeedSchema{}
 
 func (h HelmFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a Helm Feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"feed_uri":                             GetFeedUriResourceSchema(),
@@ -29,6 +29,10 @@ func (h HelmFeedSchema) GetResourceSchema() resourceSchema.Schema {
 			"username":                             GetUsernameResourceSchema(false),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 type HelmFeedTypeResourceModel struct {
@@ -40,4 +44,5 @@ type HelmFeedTypeResourceModel struct {
 	Username                          types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
@@ -11,7 +11,7 @@ const mavenFeedDescription = "maven feed"
 type MavenFeedSchema struct{}
 
 func (m MavenFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a Maven feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"download_attempts":                    GetDownloadAttemptsResourceSchema(),
@@ -25,6 +25,10 @@ func (m MavenFeedSchema) GetResourceSchema() resourceSchema.Schema {
 			"username":                             GetUsernameResourceSchema(false),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (m MavenFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -44,4 +48,5 @@ type MavenFeedTypeResourceModel struct {
 	Username                          types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
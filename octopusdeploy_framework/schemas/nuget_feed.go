@@ -14,7 +14,7 @@ type NugetFeedSchema struct{}
 var _ EntitySchema = NugetFeedSchema{}
 
 func (n NugetFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Attributes: map[string]resourceSchema.Attribute{
 			"download_attempts":              GetDownloadAttemptsResourceSchema(),
 			"download_retry_backoff_seconds": GetDownloadRetryBackoffSecondsResourceSchema(),
@@ -34,6 +34,10 @@ func (n NugetFeedSchema) GetResourceSchema() resourceSchema.Schema {
 		},
 		Description: "This resource manages a Nuget feed in Octopus Deploy.",
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (n NugetFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -52,4 +56,5 @@ type NugetFeedTypeResourceModel struct {
 	Username                          types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
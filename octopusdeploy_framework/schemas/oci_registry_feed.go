@@ -11,7 +11,7 @@ const ociRegistryFeedDescription = "OCI registry"
 type OCIRegistryFeedSchema struct{}
 
 func (m OCIRegistryFeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a OCI Registry feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"feed_uri": GetFeedUriResourceSchema(),
@@ -22,6 +22,10 @@ func (m OCIRegistryFeedSchema) GetResourceSchema() resourceSchema.Schema {
 			"username": GetUsernameResourceSchema(false),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func (m OCIRegistryFeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -38,4 +42,5 @@ type OCIRegistryFeedTypeResourceModel struct {
 	Username types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
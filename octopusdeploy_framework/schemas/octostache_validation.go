@@ -0,0 +1,86 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/octostache"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type octostacheValidator struct{}
+
+// NewOctostacheValidator warns about values that are not valid Octostache templates, such as an expression missing its
+// closing brace or an #{if} block without an #{/if}. Octopus substitutes variables when a deployment runs, so these are
+// reported as warnings rather than errors.
+func NewOctostacheValidator() octostacheValidator {
+	return octostacheValidator{}
+}
+
+func (v octostacheValidator) Description(ctx context.Context) string {
+	return "validates that the value is a well-formed Octostache template"
+}
+
+func (v octostacheValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v octostacheValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := octostache.Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Invalid variable expression",
+			fmt.Sprintf("The value of %s is not a valid Octostache template and will not be substituted as expected: %s.", req.Path, err),
+		)
+	}
+}
+
+type octostachePropertiesValidator struct{}
+
+// NewOctostachePropertiesValidator warns about each value of a map of properties that is not a valid Octostache
+// template.
+func NewOctostachePropertiesValidator() octostachePropertiesValidator {
+	return octostachePropertiesValidator{}
+}
+
+func (v octostachePropertiesValidator) Description(ctx context.Context) string {
+	return "validates that each value is a well-formed Octostache template"
+}
+
+func (v octostachePropertiesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v octostachePropertiesValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := elements[key].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if err := octostache.Validate(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtMapKey(key),
+				"Invalid variable expression",
+				fmt.Sprintf("The value of %s is not a valid Octostache template and will not be substituted as expected: %s.", req.Path.AtMapKey(key), err),
+			)
+		}
+	}
+}
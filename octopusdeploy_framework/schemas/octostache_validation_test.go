@@ -0,0 +1,44 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOctostachePropertiesValidatorWarnsAboutInvalidProperties(t *testing.T) {
+	properties := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Octopus.Action.Script.ScriptBody": types.StringValue("echo #{if Enabled}on"),
+		"Octopus.Action.Script.Syntax":     types.StringValue("Bash"),
+		"Octopus.Action.RunOnServer":       types.StringValue("true"),
+	})
+	req := validator.MapRequest{Path: path.Root("properties"), ConfigValue: properties}
+
+	resp := &validator.MapResponse{}
+	runOnServerPropertyValidator{}.ValidateMap(context.Background(), req, resp)
+	NewOctostachePropertiesValidator().ValidateMap(context.Background(), req, resp)
+	require.Len(t, resp.Diagnostics, 2)
+	require.False(t, resp.Diagnostics.HasError())
+
+	invalid := resp.Diagnostics[1]
+	require.Equal(t, diag.SeverityWarning, invalid.Severity())
+	require.Equal(t, "Invalid variable expression", invalid.Summary())
+	require.Equal(t, path.Root("properties").AtMapKey("Octopus.Action.Script.ScriptBody"), invalid.(diag.DiagnosticWithPath).Path())
+}
+
+func TestOctostacheValidator(t *testing.T) {
+	validate := func(value string) diag.Diagnostics {
+		resp := &validator.StringResponse{}
+		NewOctostacheValidator().ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(value)}, resp)
+		return resp.Diagnostics
+	}
+
+	require.Empty(t, validate("#{Octopus.Deployment.Error | ToLower} == \"\""))
+	require.Len(t, validate("#{Octopus.Deployment.Error"), 1)
+}
@@ -0,0 +1,54 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ProcessChildStepResourceDescription = "process_child_step"
+
+type ProcessChildStepSchema struct{}
+
+var _ EntitySchema = ProcessChildStepSchema{}
+
+func (p ProcessChildStepSchema) GetResourceSchema() resourceSchema.Schema {
+	attributes := map[string]resourceSchema.Attribute{
+		"id": GetIdResourceSchema(),
+		"parent_id": util.ResourceString().
+			Required().
+			PlanModifiers(stringplanmodifier.RequiresReplace()).
+			Description("The ID of the `octopusdeploy_process_step` the child step belongs to.").
+			Build(),
+		"name": util.ResourceString().
+			Required().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The name of the child step.").
+			Build(),
+	}
+	addProcessStepLocationAttributes(attributes)
+	addProcessActionAttributes(attributes, "child step")
+
+	return resourceSchema.Schema{
+		Description: "This resource manages a child step of a step in the deployment process of a project in Octopus Deploy. Child steps run after the action of their parent step, in the order they were created, on the deployment targets of the parent step.",
+		Attributes:  attributes,
+	}
+}
+
+func (p ProcessChildStepSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type ProcessChildStepResourceModel struct {
+	SpaceID   types.String `tfsdk:"space_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Branch    types.String `tfsdk:"branch"`
+	ParentID  types.String `tfsdk:"parent_id"`
+	Name      types.String `tfsdk:"name"`
+
+	ProcessActionModel
+	ResourceModel
+}
@@ -0,0 +1,264 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ProcessStepResourceDescription = "process_step"
+
+// The step properties modelled by typed attributes of octopusdeploy_process_step, which are left out of its
+// properties attribute.
+const (
+	StepConditionExpressionProperty = "Octopus.Step.ConditionVariableExpression"
+	StepTargetRolesProperty         = "Octopus.Action.TargetRoles"
+	StepWindowSizeProperty          = "Octopus.Action.MaxParallelism"
+)
+
+type ProcessStepSchema struct{}
+
+var _ EntitySchema = ProcessStepSchema{}
+
+func (p ProcessStepSchema) GetResourceSchema() resourceSchema.Schema {
+	attributes := map[string]resourceSchema.Attribute{
+		"id": GetIdResourceSchema(),
+		"name": util.ResourceString().
+			Required().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The name of the step.").
+			Build(),
+		"start_trigger": util.ResourceString().
+			Optional().
+			Computed().
+			Default("StartAfterPrevious").
+			Validators(stringvalidator.OneOf("StartAfterPrevious", "StartWithPrevious")).
+			Description("Whether to run the step after the previous step (`StartAfterPrevious`) or at the same time as the previous step (`StartWithPrevious`).").
+			Build(),
+		"package_requirement": util.ResourceString().
+			Optional().
+			Computed().
+			Default("LetOctopusDecide").
+			Validators(stringvalidator.OneOf("AfterPackageAcquisition", "BeforePackageAcquisition", "LetOctopusDecide")).
+			Description("Whether to run the step before or after package acquisition, if possible. Valid values are `AfterPackageAcquisition`, `BeforePackageAcquisition` and `LetOctopusDecide`.").
+			Build(),
+		"condition": util.ResourceString().
+			Optional().
+			Computed().
+			Default("Success").
+			Validators(stringvalidator.OneOf("Always", "Failure", "Success", "Variable")).
+			Description("When to run the step. Valid values are `Always`, `Failure`, `Success` and `Variable`.").
+			Build(),
+		"condition_expression": util.ResourceString().
+			Optional().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The expression that decides whether to run the step when `condition` is `Variable`.").
+			Build(),
+		"target_roles": util.ResourceSet(types.StringType).
+			Optional().
+			Description("The roles of the deployment targets the step runs on, or on behalf of.").
+			Build(),
+		"window_size": util.ResourceString().
+			Optional().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The maximum number of deployment targets to run the step on at the same time.").
+			Build(),
+		"properties": util.ResourceMap(types.StringType).
+			Optional().
+			Computed().
+			PlanModifiers(mapplanmodifier.UseStateForUnknown()).
+			Description("Other properties of the step. When set, only the given properties are managed, and properties added by Octopus Deploy are left as they are.").
+			Build(),
+	}
+	addProcessStepLocationAttributes(attributes)
+	addProcessActionAttributes(attributes, "step")
+
+	return resourceSchema.Schema{
+		Description: "This resource manages a single step of the deployment process of a project in Octopus Deploy, so steps of a shared project can be owned by different configurations. The step is added to the end of the process; use `octopusdeploy_process_steps_order` to order the steps. Do not manage a process with both this resource and `octopusdeploy_deployment_process`.",
+		Attributes:  attributes,
+	}
+}
+
+func (p ProcessStepSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+// addProcessStepLocationAttributes adds the attributes that locate the deployment process a step, child step or step
+// order belongs to.
+func addProcessStepLocationAttributes(attributes map[string]resourceSchema.Attribute) {
+	attributes["space_id"] = util.ResourceString().
+		Optional().
+		Computed().
+		PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+		Description("The space ID of the project.").
+		Build()
+	attributes["project_id"] = util.ResourceString().
+		Required().
+		PlanModifiers(stringplanmodifier.RequiresReplace()).
+		Description("The ID of the project whose deployment process is changed.").
+		Build()
+	attributes["branch"] = util.ResourceString().
+		Optional().
+		PlanModifiers(stringplanmodifier.RequiresReplace()).
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The branch of a version-controlled project to change, such as `main`. Defaults to the default branch of the project. This value only applies to projects that are stored in version control.").
+		Build()
+}
+
+// addProcessActionAttributes adds the attributes of the action run by a step or child step. noun names the resource
+// in attribute descriptions.
+func addProcessActionAttributes(attributes map[string]resourceSchema.Attribute, noun string) {
+	attributes["slug"] = util.ResourceString().
+		Computed().
+		PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+		Description("The slug of the action the " + noun + " runs, which Octopus Deploy generates from its name when it is created and keeps when it is renamed.").
+		Build()
+	attributes["type"] = util.ResourceString().
+		Required().
+		Validators(stringvalidator.LengthAtLeast(1)).
+		Description("The type of action the " + noun + " runs, such as `Octopus.Script` or `Octopus.KubernetesRunScript`.").
+		Build()
+	attributes["is_disabled"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(false).
+		Description("Whether the " + noun + " is disabled.").
+		Build()
+	attributes["is_required"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(false).
+		Description("Whether the " + noun + " is required, so it cannot be skipped when deploying.").
+		Build()
+	attributes["notes"] = util.ResourceString().
+		Optional().
+		Description("The notes of the " + noun + ".").
+		Build()
+	attributes["worker_pool_id"] = util.ResourceString().
+		Optional().
+		Validators(stringvalidator.ConflictsWith(path.MatchRoot("worker_pool_variable"))).
+		Description("The ID of the worker pool the " + noun + " runs on.").
+		Build()
+	attributes["worker_pool_variable"] = util.ResourceString().
+		Optional().
+		Description("The name of the variable that holds the worker pool the " + noun + " runs on.").
+		Build()
+	attributes["environments"] = util.ResourceSet(types.StringType).
+		Optional().
+		Description("The IDs of the environments the " + noun + " runs in. When not set, it runs in every environment.").
+		Build()
+	attributes["excluded_environments"] = util.ResourceSet(types.StringType).
+		Optional().
+		Description("The IDs of the environments the " + noun + " is skipped in.").
+		Build()
+	attributes["channels"] = util.ResourceSet(types.StringType).
+		Optional().
+		Description("The IDs of the channels the " + noun + " runs in. When not set, it runs in every channel.").
+		Build()
+	attributes["tenant_tags"] = util.ResourceSet(types.StringType).
+		Optional().
+		Description("The tenant tags, in the form `tag set/tag`, of the tenants the " + noun + " runs for.").
+		Build()
+	attributes["primary_package"] = resourceSchema.SingleNestedAttribute{
+		Description: "The primary package of the " + noun + ".",
+		Optional:    true,
+		Attributes:  getProcessPackageAttributes(),
+	}
+	attributes["packages"] = resourceSchema.MapNestedAttribute{
+		Description:  "The other packages referenced by the " + noun + ", keyed by the name of the package reference.",
+		Optional:     true,
+		NestedObject: resourceSchema.NestedAttributeObject{Attributes: getProcessPackageAttributes()},
+	}
+	attributes["execution_properties"] = util.ResourceMap(types.StringType).
+		Optional().
+		Computed().
+		PlanModifiers(mapplanmodifier.UseStateForUnknown()).
+		Description("The properties of the action the " + noun + " runs, such as `Octopus.Action.Script.ScriptBody`. When set, only the given properties are managed, and properties added by Octopus Deploy are left as they are.").
+		Build()
+}
+
+func getProcessPackageAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"package_id": util.ResourceString().
+			Required().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The ID of the package.").
+			Build(),
+		"feed_id": util.ResourceString().
+			Required().
+			Validators(stringvalidator.LengthAtLeast(1)).
+			Description("The ID of the feed the package is acquired from.").
+			Build(),
+		"acquisition_location": util.ResourceString().
+			Optional().
+			Computed().
+			Default("Server").
+			Description("Where the package is acquired: `Server`, `ExecutionTarget`, `NotAcquired` or an expression.").
+			Build(),
+		"properties": resourceSchema.MapAttribute{
+			Description:   "The properties of the package reference. When set, only the given properties are managed, and properties added by Octopus Deploy are left as they are.",
+			ElementType:   types.StringType,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+type ProcessStepResourceModel struct {
+	SpaceID             types.String `tfsdk:"space_id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	Branch              types.String `tfsdk:"branch"`
+	Name                types.String `tfsdk:"name"`
+	StartTrigger        types.String `tfsdk:"start_trigger"`
+	PackageRequirement  types.String `tfsdk:"package_requirement"`
+	Condition           types.String `tfsdk:"condition"`
+	ConditionExpression types.String `tfsdk:"condition_expression"`
+	TargetRoles         types.Set    `tfsdk:"target_roles"`
+	WindowSize          types.String `tfsdk:"window_size"`
+	Properties          types.Map    `tfsdk:"properties"`
+
+	ProcessActionModel
+	ResourceModel
+}
+
+// ProcessActionModel holds the attributes of the action run by a step or child step.
+type ProcessActionModel struct {
+	Slug                 types.String `tfsdk:"slug"`
+	Type                 types.String `tfsdk:"type"`
+	IsDisabled           types.Bool   `tfsdk:"is_disabled"`
+	IsRequired           types.Bool   `tfsdk:"is_required"`
+	Notes                types.String `tfsdk:"notes"`
+	WorkerPoolID         types.String `tfsdk:"worker_pool_id"`
+	WorkerPoolVariable   types.String `tfsdk:"worker_pool_variable"`
+	Environments         types.Set    `tfsdk:"environments"`
+	ExcludedEnvironments types.Set    `tfsdk:"excluded_environments"`
+	Channels             types.Set    `tfsdk:"channels"`
+	TenantTags           types.Set    `tfsdk:"tenant_tags"`
+	PrimaryPackage       types.Object `tfsdk:"primary_package"`
+	Packages             types.Map    `tfsdk:"packages"`
+	ExecutionProperties  types.Map    `tfsdk:"execution_properties"`
+}
+
+type ProcessPackageModel struct {
+	PackageID           types.String `tfsdk:"package_id"`
+	FeedID              types.String `tfsdk:"feed_id"`
+	AcquisitionLocation types.String `tfsdk:"acquisition_location"`
+	Properties          types.Map    `tfsdk:"properties"`
+}
+
+func ProcessPackageObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"package_id":           types.StringType,
+		"feed_id":              types.StringType,
+		"acquisition_location": types.StringType,
+		"properties":           types.MapType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ProcessStepsOrderResourceDescription = "process_steps_order"
+
+type ProcessStepsOrderSchema struct{}
+
+var _ EntitySchema = ProcessStepsOrderSchema{}
+
+func (p ProcessStepsOrderSchema) GetResourceSchema() resourceSchema.Schema {
+	attributes := map[string]resourceSchema.Attribute{
+		"id": GetIdResourceSchema(),
+		"steps": resourceSchema.ListAttribute{
+			Description: "The steps, in the order they run, each given by its ID or by the `slug` of its `octopusdeploy_process_step`. Octopus Deploy gives steps no slug of their own, so the slug of a step is the slug of the action it runs, which is generated from the step name when the step is created and does not change when the step is renamed. Use slugs for version-controlled projects, where the IDs of steps can change. Steps of the process that are not listed run after the listed steps, in their current order.",
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	}
+	addProcessStepLocationAttributes(attributes)
+
+	return resourceSchema.Schema{
+		Description: "This resource sets the order of the steps in the deployment process of a project in Octopus Deploy. Destroying it leaves the steps in their current order.",
+		Attributes:  attributes,
+	}
+}
+
+func (p ProcessStepsOrderSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type ProcessStepsOrderResourceModel struct {
+	SpaceID   types.String `tfsdk:"space_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Branch    types.String `tfsdk:"branch"`
+	Steps     types.List   `tfsdk:"steps"`
+
+	ResourceModel
+}
@@ -0,0 +1,137 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keepZeroValuesFromState makes the optional attributes of a block, and of the blocks nested in it, keep a zero value
+// saved in the state when they are not configured. The SDK implementation of the process resources saved every unset
+// optional attribute as its zero value, which can't be told apart from one that is set to it, so the upgraded state
+// keeps them and a configuration that sets or leaves out such an attribute shows no changes.
+func keepZeroValuesFromState(block resourceSchema.NestedBlockObject) resourceSchema.NestedBlockObject {
+	for name, attribute := range block.Attributes {
+		if !attribute.IsOptional() || attribute.IsComputed() {
+			continue
+		}
+
+		switch a := attribute.(type) {
+		case resourceSchema.StringAttribute:
+			a.Computed = true
+			a.PlanModifiers = append(a.PlanModifiers, zeroValueFromState{})
+			block.Attributes[name] = a
+		case resourceSchema.BoolAttribute:
+			a.Computed = true
+			a.PlanModifiers = append(a.PlanModifiers, zeroValueFromState{})
+			block.Attributes[name] = a
+		case resourceSchema.Int64Attribute:
+			a.Computed = true
+			a.PlanModifiers = append(a.PlanModifiers, zeroValueFromState{})
+			block.Attributes[name] = a
+		case resourceSchema.ListAttribute:
+			a.Computed = true
+			a.PlanModifiers = append(a.PlanModifiers, zeroValueFromState{})
+			block.Attributes[name] = a
+		case resourceSchema.MapAttribute:
+			a.Computed = true
+			a.PlanModifiers = append(a.PlanModifiers, zeroValueFromState{})
+			block.Attributes[name] = a
+		}
+	}
+
+	for name, nested := range block.Blocks {
+		switch b := nested.(type) {
+		case resourceSchema.ListNestedBlock:
+			b.NestedObject = keepZeroValuesFromState(b.NestedObject)
+			block.Blocks[name] = b
+		case resourceSchema.SetNestedBlock:
+			b.NestedObject = keepZeroValuesFromState(b.NestedObject)
+			block.Blocks[name] = b
+		}
+	}
+
+	return block
+}
+
+// zeroValueFromState plans the value of an attribute that is not configured as null, unless the state holds its zero
+// value.
+type zeroValueFromState struct{}
+
+var (
+	_ planmodifier.String = zeroValueFromState{}
+	_ planmodifier.Bool   = zeroValueFromState{}
+	_ planmodifier.Int64  = zeroValueFromState{}
+	_ planmodifier.List   = zeroValueFromState{}
+	_ planmodifier.Map    = zeroValueFromState{}
+)
+
+func (zeroValueFromState) Description(_ context.Context) string {
+	return "When not configured, the attribute keeps an empty value saved in the state and is otherwise null."
+}
+
+func (m zeroValueFromState) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (zeroValueFromState) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if hasStateValue(req.StateValue) && req.StateValue.ValueString() == "" {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.StringNull()
+}
+
+func (zeroValueFromState) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if hasStateValue(req.StateValue) && !req.StateValue.ValueBool() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.BoolNull()
+}
+
+func (zeroValueFromState) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if hasStateValue(req.StateValue) && req.StateValue.ValueInt64() == 0 {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.Int64Null()
+}
+
+func (zeroValueFromState) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if hasStateValue(req.StateValue) && len(req.StateValue.Elements()) == 0 {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.ListNull(req.PlanValue.ElementType(ctx))
+}
+
+func (zeroValueFromState) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if hasStateValue(req.StateValue) && len(req.StateValue.Elements()) == 0 {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.MapNull(req.PlanValue.ElementType(ctx))
+}
+
+func hasStateValue(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
@@ -0,0 +1,53 @@
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSchemaKeepsZeroValuesFromState(t *testing.T) {
+	step := DeploymentProcessSchema{}.GetResourceSchema().Blocks["step"].(resourceSchema.ListNestedBlock).NestedObject
+	action := step.Blocks["apply_terraform_template_action"].(resourceSchema.ListNestedBlock).NestedObject
+	template := action.Blocks["template"].(resourceSchema.SetNestedBlock).NestedObject
+
+	attribute := template.Attributes["run_automatic_file_substitution"].(resourceSchema.BoolAttribute)
+	require.True(t, attribute.Optional)
+	require.True(t, attribute.Computed)
+	require.Contains(t, attribute.PlanModifiers, planmodifier.Bool(zeroValueFromState{}))
+
+	windowSize := step.Attributes["window_size"].(resourceSchema.StringAttribute)
+	require.True(t, windowSize.Computed)
+	require.Contains(t, windowSize.PlanModifiers, planmodifier.String(zeroValueFromState{}))
+}
+
+func TestZeroValueFromState(t *testing.T) {
+	plan := func(config types.Bool, state types.Bool) types.Bool {
+		resp := &planmodifier.BoolResponse{PlanValue: types.BoolUnknown()}
+		if !config.IsNull() {
+			resp.PlanValue = config
+		}
+		zeroValueFromState{}.PlanModifyBool(context.Background(), planmodifier.BoolRequest{ConfigValue: config, StateValue: state, PlanValue: resp.PlanValue}, resp)
+		return resp.PlanValue
+	}
+
+	require.Equal(t, types.BoolValue(false), plan(types.BoolNull(), types.BoolValue(false)))
+	require.Equal(t, types.BoolValue(false), plan(types.BoolValue(false), types.BoolValue(false)))
+	require.Equal(t, types.BoolValue(false), plan(types.BoolValue(false), types.BoolNull()))
+	require.Equal(t, types.BoolNull(), plan(types.BoolNull(), types.BoolValue(true)))
+	require.Equal(t, types.BoolNull(), plan(types.BoolNull(), types.BoolNull()))
+
+	list := func(config types.List, state types.List) types.List {
+		resp := &planmodifier.ListResponse{PlanValue: types.ListUnknown(types.StringType)}
+		zeroValueFromState{}.PlanModifyList(context.Background(), planmodifier.ListRequest{ConfigValue: config, StateValue: state, PlanValue: resp.PlanValue}, resp)
+		return resp.PlanValue
+	}
+
+	empty := types.ListValueMust(types.StringType, nil)
+	require.Equal(t, empty, list(types.ListNull(types.StringType), empty))
+	require.Equal(t, types.ListNull(types.StringType), list(types.ListNull(types.StringType), types.ListNull(types.StringType)))
+}
@@ -0,0 +1,237 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ReleaseResourceDescription = "release"
+
+// ReleaseImportedPrivateKey is the key of the private data recording that a release was imported. The attributes that
+// only describe how a release is created cannot be read back from it, so after an import they are taken from the
+// configuration rather than replacing the release.
+const ReleaseImportedPrivateKey = "imported"
+
+type ReleaseSchema struct{}
+
+var _ EntitySchema = ReleaseSchema{}
+
+func (r ReleaseSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "This resource creates a release of a project in Octopus Deploy. Releases cannot be changed, so any change to the configuration creates a new release. After an import, the attributes that only describe how the release is created, such as `packages` and `git_ref`, are taken from the configuration without creating a new release.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this release.").
+				Build(),
+			"project_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The ID of the project to create the release for.").
+				Build(),
+			"channel_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The ID of the channel to create the release in. Defaults to the default channel of the project.").
+				Build(),
+			"version": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The version of the release. When not set, the version is chosen by the versioning strategy of the project.").
+				Build(),
+			"default_package_version": util.ResourceString().
+				Optional().
+				PlanModifiers(requiresReplaceUnlessImportedString()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The version of every package in the release that is not given a version by `packages`. When not set, the latest version allowed by the channel is used.").
+				Build(),
+			"packages": getReleasePackagesResourceAttribute(),
+			"release_notes": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The release notes of the release.").
+				Build(),
+			"git_ref": util.ResourceString().
+				Optional().
+				PlanModifiers(requiresReplaceUnlessImportedString()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The Git reference, such as `refs/heads/main`, to create the release from, for version-controlled projects.").
+				Build(),
+			"git_commit": util.ResourceString().
+				Optional().
+				PlanModifiers(requiresReplaceUnlessImportedString()).
+				Validators(stringvalidator.LengthAtLeast(1), stringvalidator.AlsoRequires(path.MatchRoot("git_ref"))).
+				Description("The Git commit to create the release from, for version-controlled projects. Requires `git_ref`.").
+				Build(),
+			"ignore_channel_rules": util.ResourceBool().
+				Optional().
+				Computed().
+				Default(false).
+				PlanModifiers(boolplanmodifier.RequiresReplace()).
+				Description("Whether to create the release even when its package versions do not satisfy the rules of the channel.").
+				Build(),
+			"selected_packages": getSelectedPackagesResourceAttribute("The packages, and their versions, selected for the release."),
+		},
+	}
+}
+
+func getReleasePackagesResourceAttribute() resourceSchema.ListNestedAttribute {
+	attribute := getPackageVersionsResourceAttribute("The versions of specific packages in the release.")
+	attribute.PlanModifiers = []planmodifier.List{requiresReplaceUnlessImportedList()}
+	return attribute
+}
+
+// getPackageVersionsResourceAttribute returns the attribute that sets the versions of specific packages, for resources
+// that select package versions such as releases and runbook snapshots.
+func getPackageVersionsResourceAttribute(description string) resourceSchema.ListNestedAttribute {
+	return resourceSchema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: resourceSchema.NestedAttributeObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"step_name": util.ResourceString().
+					Required().
+					Validators(stringvalidator.LengthAtLeast(1)).
+					Description("The name of the step, or the ID of the package, to set the version of.").
+					Build(),
+				"package_reference_name": util.ResourceString().
+					Optional().
+					Validators(stringvalidator.LengthAtLeast(1)).
+					Description("The name of the package reference, for steps that reference more than one package.").
+					Build(),
+				"version": util.ResourceString().
+					Required().
+					Validators(stringvalidator.LengthAtLeast(1)).
+					Description("The version of the package.").
+					Build(),
+			},
+		},
+	}
+}
+
+// getSelectedPackagesResourceAttribute returns the computed attribute listing the package versions selected for a
+// release or runbook snapshot.
+func getSelectedPackagesResourceAttribute(description string) resourceSchema.ListNestedAttribute {
+	return resourceSchema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: resourceSchema.NestedAttributeObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"step_name":              util.ResourceString().Computed().Description("The name of the step that references the package.").Build(),
+				"action_name":            util.ResourceString().Computed().Description("The name of the action that references the package.").Build(),
+				"package_reference_name": util.ResourceString().Computed().Description("The name of the package reference.").Build(),
+				"version":                util.ResourceString().Computed().Description("The version of the package.").Build(),
+			},
+		},
+	}
+}
+
+// privateData reads the private data of a resource in a plan modifier.
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// requiresReplaceUnlessImported reports whether a change to an attribute that cannot be read back from a release
+// replaces it. The attribute is null after an import, so setting it adopts the configured value instead.
+func requiresReplaceUnlessImported(ctx context.Context, state attr.Value, private privateData) (bool, diag.Diagnostics) {
+	if !state.IsNull() {
+		return true, nil
+	}
+
+	imported, diags := private.GetKey(ctx, ReleaseImportedPrivateKey)
+	return imported == nil, diags
+}
+
+const requiresReplaceUnlessImportedDescription = "Changing this attribute creates a new release, unless the release was imported without it."
+
+func requiresReplaceUnlessImportedString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = requiresReplaceUnlessImported(ctx, req.StateValue, req.Private)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedList() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = requiresReplaceUnlessImported(ctx, req.StateValue, req.Private)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func (r ReleaseSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type ReleaseResourceModel struct {
+	SpaceID               types.String `tfsdk:"space_id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	ChannelID             types.String `tfsdk:"channel_id"`
+	Version               types.String `tfsdk:"version"`
+	DefaultPackageVersion types.String `tfsdk:"default_package_version"`
+	Packages              types.List   `tfsdk:"packages"`
+	ReleaseNotes          types.String `tfsdk:"release_notes"`
+	GitRef                types.String `tfsdk:"git_ref"`
+	GitCommit             types.String `tfsdk:"git_commit"`
+	IgnoreChannelRules    types.Bool   `tfsdk:"ignore_channel_rules"`
+	SelectedPackages      types.List   `tfsdk:"selected_packages"`
+
+	ResourceModel
+}
+
+type ReleasePackageModel struct {
+	StepName             types.String `tfsdk:"step_name"`
+	PackageReferenceName types.String `tfsdk:"package_reference_name"`
+	Version              types.String `tfsdk:"version"`
+}
+
+type ReleaseSelectedPackageModel struct {
+	StepName             types.String `tfsdk:"step_name"`
+	ActionName           types.String `tfsdk:"action_name"`
+	PackageReferenceName types.String `tfsdk:"package_reference_name"`
+	Version              types.String `tfsdk:"version"`
+}
+
+func ReleaseSelectedPackageObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"step_name":              types.StringType,
+		"action_name":            types.StringType,
+		"package_reference_name": types.StringType,
+		"version":                types.StringType,
+	}
+}
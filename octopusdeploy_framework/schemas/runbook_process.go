@@ -0,0 +1,65 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const RunbookProcessResourceDescription = "runbook_process"
+
+type RunbookProcessSchema struct{}
+
+var _ EntitySchema = RunbookProcessSchema{}
+
+func (r RunbookProcessSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "This resource manages runbook processes in Octopus Deploy.",
+		Version:     DeploymentProcessSchemaVersion,
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"last_snapshot_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Description("Read only value containing the last snapshot ID.").
+				Build(),
+			"project_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Description("The project ID associated with this runbook process.").
+				Build(),
+			"runbook_id": util.ResourceString().
+				Required().
+				Description("The runbook ID associated with this runbook process.").
+				Build(),
+			"space_id": GetSpaceIdResourceSchema("runbook process"),
+			"version": util.ResourceInt64().
+				Optional().
+				Computed().
+				Description("The version number of this runbook process.").
+				Build(),
+		},
+		Blocks: map[string]resourceSchema.Block{
+			"step": getDeploymentStepBlock(),
+		},
+	}
+}
+
+func (r RunbookProcessSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type RunbookProcessResourceModel struct {
+	LastSnapshotID types.String          `tfsdk:"last_snapshot_id"`
+	ProjectID      types.String          `tfsdk:"project_id"`
+	RunbookID      types.String          `tfsdk:"runbook_id"`
+	SpaceID        types.String          `tfsdk:"space_id"`
+	Steps          []DeploymentStepModel `tfsdk:"step"`
+	Version        types.Int64           `tfsdk:"version"`
+
+	ResourceModel
+}
@@ -0,0 +1,87 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const RunbookRunResourceDescription = "runbook_run"
+
+type RunbookRunSchema struct{}
+
+var _ EntitySchema = RunbookRunSchema{}
+
+func (r RunbookRunSchema) GetResourceSchema() resourceSchema.Schema {
+	s := resourceSchema.Schema{
+		Description: "This resource runs a runbook in Octopus Deploy, and optionally waits for the run to complete. Any change to the run configuration runs the runbook again.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this runbook run.").
+				Build(),
+			"runbook_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The ID of the runbook to run.").
+				Build(),
+			"snapshot_id": util.ResourceString().
+				Optional().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The ID of the runbook snapshot to run. When not set, the published snapshot of the runbook is run.").
+				Build(),
+			"environment_ids": util.ResourceSet(types.StringType).
+				Required().
+				PlanModifiers(setplanmodifier.RequiresReplace()).
+				Validators(setvalidator.SizeAtLeast(1)).
+				Description("The IDs of the environments to run the runbook in.").
+				Build(),
+			"tenant_ids": util.ResourceSet(types.StringType).
+				Optional().
+				PlanModifiers(setplanmodifier.RequiresReplace()).
+				Validators(setvalidator.SizeAtLeast(1)).
+				Description("The IDs of the tenants to run the runbook for. The runbook is run for each tenant in each environment.").
+				Build(),
+			"runbook_run_ids": util.ResourceList(types.StringType).
+				Computed().
+				PlanModifiers(listplanmodifier.UseStateForUnknown()).
+				Description("The IDs of the runbook runs created, one for each environment and tenant.").
+				Build(),
+			"task_ids": util.ResourceList(types.StringType).
+				Computed().
+				PlanModifiers(listplanmodifier.UseStateForUnknown()).
+				Description("The IDs of the server tasks that run the runbook.").
+				Build(),
+		},
+	}
+
+	addServerTaskExecutionAttributes(s.Attributes, "runbook run")
+	return s
+}
+
+func (r RunbookRunSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type RunbookRunResourceModel struct {
+	SpaceID        types.String `tfsdk:"space_id"`
+	RunbookID      types.String `tfsdk:"runbook_id"`
+	SnapshotID     types.String `tfsdk:"snapshot_id"`
+	EnvironmentIDs types.Set    `tfsdk:"environment_ids"`
+	TenantIDs      types.Set    `tfsdk:"tenant_ids"`
+	RunbookRunIDs  types.List   `tfsdk:"runbook_run_ids"`
+	TaskIDs        types.List   `tfsdk:"task_ids"`
+
+	ServerTaskExecutionModel
+	ResourceModel
+}
@@ -0,0 +1,93 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const RunbookSnapshotResourceDescription = "runbook_snapshot"
+
+type RunbookSnapshotSchema struct{}
+
+var _ EntitySchema = RunbookSnapshotSchema{}
+
+func (r RunbookSnapshotSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "This resource creates a snapshot of a runbook in Octopus Deploy, and optionally publishes it. A new snapshot is created whenever the runbook process changes.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this runbook snapshot.").
+				Build(),
+			"runbook_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The ID of the runbook to snapshot.").
+				Build(),
+			"project_id": util.ResourceString().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Description("The ID of the project the runbook belongs to.").
+				Build(),
+			"name": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The name of the snapshot. When not set, the next name suggested by Octopus Deploy is used.").
+				Build(),
+			"notes": util.ResourceString().
+				Optional().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Description("The notes of the snapshot.").
+				Build(),
+			"default_package_version": util.ResourceString().
+				Optional().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The version of every package in the snapshot that is not given a version by `packages`. When not set, the latest version in the feed is used.").
+				Build(),
+			"packages": getPackageVersionsResourceAttribute("The versions of specific packages in the snapshot."),
+			"publish": util.ResourceBool().
+				Optional().
+				Computed().
+				Default(false).
+				Description("Whether to publish the snapshot, so it is the one run by default. When another snapshot is published outside Terraform, the next apply publishes this snapshot again.").
+				Build(),
+			"runbook_process_version": util.ResourceInt64().
+				Optional().
+				Computed().
+				PlanModifiers(int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplace()).
+				Description("The version of the runbook process the snapshot was created from. When not set, it is read from Octopus Deploy, and a new snapshot is planned once the process has changed. Set it to the `version` of an `octopusdeploy_runbook_process` to create the new snapshot in the same apply that changes the process.").
+				Build(),
+			"selected_packages": getSelectedPackagesResourceAttribute("The packages, and their versions, selected for the snapshot."),
+		},
+	}
+}
+
+func (r RunbookSnapshotSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+type RunbookSnapshotResourceModel struct {
+	SpaceID               types.String `tfsdk:"space_id"`
+	RunbookID             types.String `tfsdk:"runbook_id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	Name                  types.String `tfsdk:"name"`
+	Notes                 types.String `tfsdk:"notes"`
+	DefaultPackageVersion types.String `tfsdk:"default_package_version"`
+	Packages              types.List   `tfsdk:"packages"`
+	Publish               types.Bool   `tfsdk:"publish"`
+	RunbookProcessVersion types.Int64  `tfsdk:"runbook_process_version"`
+	SelectedPackages      types.List   `tfsdk:"selected_packages"`
+
+	ResourceModel
+}
@@ -9,7 +9,7 @@ import (
 type S3FeedSchema struct{}
 
 func (m S3FeedSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: "This resource manages a Amazon S3 Bucket feed in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"use_machine_credentials": GetRequiredBooleanResourceAttribute("When true will use credentials configured on the worker"),
@@ -22,6 +22,10 @@ func (m S3FeedSchema) GetResourceSchema() resourceSchema.Schema {
 			"username":                GetUsernameResourceSchema(false),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password", "secret_key")
+
+	return s
 }
 
 func (m S3FeedSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -40,4 +44,6 @@ type S3FeedTypeResourceModel struct {
 	Username              types.String `tfsdk:"username"`
 
 	ResourceModel
+	SecretKeyWriteOnlyModel
+	PasswordWriteOnlyModel
 }
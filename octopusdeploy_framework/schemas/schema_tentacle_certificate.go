@@ -1,15 +1,20 @@
 package schemas
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	ephemeralSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func GetTentacleCertificateSchema() resourceSchema.Schema {
 	return resourceSchema.Schema{
-		Description: "Generates a X.509 self-signed certificate for use with a Octopus Deploy Tentacle.",
+		Description: "Generates a X.509 self-signed certificate for use with a Octopus Deploy Tentacle, or imports an existing certificate.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"id": resourceSchema.StringAttribute{
 				Description: "The unique ID for this resource.",
@@ -24,6 +29,42 @@ func GetTentacleCertificateSchema() resourceSchema.Schema {
 				Computed:    true,
 				Description: "The SHA1 sum of the certificate represented in hexadecimal.",
 			},
+			"expires": resourceSchema.StringAttribute{
+				Computed:    true,
+				Description: "When the certificate expires, in RFC 3339 format.",
+			},
+			"certificate_data": resourceSchema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An existing certificate to use instead of generating one, either a base64 encoded pfx file or PEM encoded certificate and private key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": resourceSchema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the pfx file or encrypted private key in `certificate_data`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("certificate_data")),
+				},
+			},
+			"rotate_after": resourceSchema.StringAttribute{
+				Optional:    true,
+				Description: "How long a generated certificate is valid for, such as `8760h`. Once it expires, the next plan replaces it with a new certificate. When not set, generated certificates are valid for 100 years.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("certificate_data")),
+				},
+			},
 			"dependencies": resourceSchema.MapAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -36,9 +77,45 @@ func GetTentacleCertificateSchema() resourceSchema.Schema {
 	}
 }
 
+func GetTentacleCertificateEphemeralResourceSchema() ephemeralSchema.Schema {
+	return ephemeralSchema.Schema{
+		Description: "Generates a X.509 self-signed certificate for use with a Octopus Deploy Tentacle, without storing its private key in the Terraform plan or state.",
+		Attributes: map[string]ephemeralSchema.Attribute{
+			"base64": ephemeralSchema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded pfx certificate.",
+			},
+			"thumbprint": ephemeralSchema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA1 sum of the certificate represented in hexadecimal.",
+			},
+			"expires": ephemeralSchema.StringAttribute{
+				Computed:    true,
+				Description: "When the certificate expires, in RFC 3339 format.",
+			},
+			"valid_for": ephemeralSchema.StringAttribute{
+				Optional:    true,
+				Description: "How long the certificate is valid for, such as `8760h`. Defaults to 100 years.",
+			},
+		},
+	}
+}
+
 type TentacleCertificateResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Base64       types.String `tfsdk:"base64"`
-	Thumbprint   types.String `tfsdk:"thumbprint"`
-	Dependencies types.Map    `tfsdk:"dependencies"`
+	ID              types.String `tfsdk:"id"`
+	Base64          types.String `tfsdk:"base64"`
+	Thumbprint      types.String `tfsdk:"thumbprint"`
+	Expires         types.String `tfsdk:"expires"`
+	CertificateData types.String `tfsdk:"certificate_data"`
+	Password        types.String `tfsdk:"password"`
+	RotateAfter     types.String `tfsdk:"rotate_after"`
+	Dependencies    types.Map    `tfsdk:"dependencies"`
+}
+
+type TentacleCertificateEphemeralModel struct {
+	Base64     types.String `tfsdk:"base64"`
+	Thumbprint types.String `tfsdk:"thumbprint"`
+	Expires    types.String `tfsdk:"expires"`
+	ValidFor   types.String `tfsdk:"valid_for"`
 }
@@ -36,6 +36,8 @@ var testableSchemas = []EntitySchema{
 	TenantProjectVariableSchema{},
 	TenantSchema{},
 	TenantProjectsSchema{},
+	DeploymentProcessSchema{},
+	RunbookProcessSchema{},
 }
 
 func TestDatasourceSchemaDefinitionIsUsingCorrectTypes(t *testing.T) {
@@ -0,0 +1,77 @@
+package schemas
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GuidedFailureModes are the values of guided_failure_mode, which choose whether a deployment or runbook run pauses
+// for intervention when a step fails.
+var GuidedFailureModes = []string{"EnvironmentDefault", "On", "Off"}
+
+// addServerTaskExecutionAttributes adds the attributes shared by resources that start a deployment or runbook run and
+// wait for its server task, such as the steps to skip, the targets to run on and prompted variable values. noun names
+// the execution in attribute descriptions.
+func addServerTaskExecutionAttributes(attributes map[string]resourceSchema.Attribute, noun string) {
+	attributes["guided_failure_mode"] = util.ResourceString().
+		Optional().
+		Computed().
+		Default("EnvironmentDefault").
+		PlanModifiers(stringplanmodifier.RequiresReplace()).
+		Validators(stringvalidator.OneOf(GuidedFailureModes...)).
+		Description(fmt.Sprintf("Whether the %s pauses for intervention when a step fails. Valid values are `EnvironmentDefault`, `On` and `Off`.", noun)).
+		Build()
+	attributes["skip_step_names"] = util.ResourceSet(types.StringType).
+		Optional().
+		PlanModifiers(setplanmodifier.RequiresReplace()).
+		Description("The names of the steps to skip.").
+		Build()
+	attributes["specific_machine_ids"] = util.ResourceSet(types.StringType).
+		Optional().
+		PlanModifiers(setplanmodifier.RequiresReplace()).
+		Description(fmt.Sprintf("The IDs of the deployment targets to use for the %s. When not set, every target in the environment is used.", noun)).
+		Build()
+	attributes["excluded_machine_ids"] = util.ResourceSet(types.StringType).
+		Optional().
+		PlanModifiers(setplanmodifier.RequiresReplace()).
+		Description(fmt.Sprintf("The IDs of the deployment targets to leave out of the %s.", noun)).
+		Build()
+	attributes["variables"] = util.ResourceMap(types.StringType).
+		Optional().
+		Sensitive().
+		PlanModifiers(mapplanmodifier.RequiresReplace()).
+		Validators(mapvalidator.SizeAtLeast(1)).
+		Description("The values of prompted variables, keyed by variable name.").
+		Build()
+	attributes["wait_for_completion"] = util.ResourceBool().
+		Optional().
+		Computed().
+		Default(true).
+		Description(fmt.Sprintf("Whether to wait for the %s to complete, and fail when it does not succeed.", noun)).
+		Build()
+	attributes["timeout"] = util.ResourceString().
+		Optional().
+		Computed().
+		Default("30m").
+		Description(fmt.Sprintf("How long to wait for the %s to complete, such as `30m` or `2h`.", noun)).
+		Build()
+}
+
+// ServerTaskExecutionModel holds the attributes added by addServerTaskExecutionAttributes.
+type ServerTaskExecutionModel struct {
+	GuidedFailureMode  types.String `tfsdk:"guided_failure_mode"`
+	SkipStepNames      types.Set    `tfsdk:"skip_step_names"`
+	SpecificMachineIDs types.Set    `tfsdk:"specific_machine_ids"`
+	ExcludedMachineIDs types.Set    `tfsdk:"excluded_machine_ids"`
+	Variables          types.Map    `tfsdk:"variables"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout            types.String `tfsdk:"timeout"`
+}
@@ -10,7 +10,7 @@ const (
 )
 
 func GetTenantCommonVariableResourceSchema() schema.Schema {
-	return schema.Schema{
+	s := schema.Schema{
 		Description: "Manages a tenant common variable in Octopus Deploy.",
 		Attributes: map[string]schema.Attribute{
 			"id":                      GetIdResourceSchema(),
@@ -25,4 +25,8 @@ func GetTenantCommonVariableResourceSchema() schema.Schema {
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "value")
+
+	return s
 }
@@ -53,7 +53,7 @@ func (t TenantProjectVariableSchema) GetDatasourceSchema() datasourceSchema.Sche
 }
 
 func (t TenantProjectVariableSchema) GetResourceSchema() schema.Schema {
-	return schema.Schema{
+	s := schema.Schema{
 		Description: "Manages a tenant project variable in Octopus Deploy.",
 		Attributes: map[string]schema.Attribute{
 			"id": GetIdResourceSchema(),
@@ -86,4 +86,8 @@ func (t TenantProjectVariableSchema) GetResourceSchema() schema.Schema {
 				Build(),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "value")
+
+	return s
 }
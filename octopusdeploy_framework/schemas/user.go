@@ -156,7 +156,7 @@ func IdentityClaimObjectType() map[string]attr.Type {
 }
 
 func (u UserSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: util.GetResourceSchemaDescription(UserResourceDescription),
 		Attributes: map[string]resourceSchema.Attribute{
 			"id":                     GetIdResourceSchema(),
@@ -192,6 +192,10 @@ func (u UserSchema) GetResourceSchema() resourceSchema.Schema {
 			},
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 func GetDisplayNameResourceSchema() resourceSchema.Attribute {
@@ -287,4 +291,5 @@ type UserTypeResourceModel struct {
 	Password types.String `tfsdk:"password"`
 
 	UserTypeDatasourceModel
+	PasswordWriteOnlyModel
 }
@@ -17,7 +17,7 @@ func (u UsernamePasswordAccountSchema) GetDatasourceSchema() datasourceSchema.Sc
 }
 
 func (u UsernamePasswordAccountSchema) GetResourceSchema() schema.Schema {
-	return schema.Schema{
+	s := schema.Schema{
 		Description: "This resource manages username-password accounts in Octopus Deploy.",
 		Attributes: map[string]schema.Attribute{
 			"id":                                GetIdResourceSchema(),
@@ -32,6 +32,10 @@ func (u UsernamePasswordAccountSchema) GetResourceSchema() schema.Schema {
 			"username":                          util.ResourceString().Required().Sensitive().Description("The username associated with this resource.").Build(),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, "password")
+
+	return s
 }
 
 type UsernamePasswordAccountResourceModel struct {
@@ -46,4 +50,5 @@ type UsernamePasswordAccountResourceModel struct {
 	Username                        types.String `tfsdk:"username"`
 
 	ResourceModel
+	PasswordWriteOnlyModel
 }
@@ -138,7 +138,7 @@ func (v VariableSchema) GetDatasourceSchema() datasourceSchema.Schema {
 }
 
 func (v VariableSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	s := resourceSchema.Schema{
 		Description: util.GetResourceSchemaDescription(VariableResourceDescription),
 		Attributes: map[string]resourceSchema.Attribute{
 			SchemaAttributeNames.ID:          GetIdResourceSchema(),
@@ -186,6 +186,7 @@ func (v VariableSchema) GetResourceSchema() resourceSchema.Schema {
 				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(VariableSchemaAttributeNames.SensitiveValue)),
+					NewOctostacheValidator(),
 				},
 			},
 		},
@@ -194,6 +195,16 @@ func (v VariableSchema) GetResourceSchema() resourceSchema.Schema {
 			VariableSchemaAttributeNames.Scope:  getVariableScopeResourceSchema(),
 		},
 	}
+
+	AddWriteOnlyAttributes(s.Attributes, VariableSchemaAttributeNames.SensitiveValue)
+
+	// A variable has either a value or a sensitive value, however the sensitive value is given.
+	writeOnlyName := VariableSchemaAttributeNames.SensitiveValue + WriteOnlySuffix
+	writeOnly := s.Attributes[writeOnlyName].(resourceSchema.StringAttribute)
+	writeOnly.Validators = append(writeOnly.Validators, stringvalidator.ConflictsWith(path.MatchRoot(VariableSchemaAttributeNames.Value)))
+	s.Attributes[writeOnlyName] = writeOnly
+
+	return s
 }
 
 type VariableTypeResourceModel struct {
@@ -211,6 +222,7 @@ type VariableTypeResourceModel struct {
 	SpaceID        types.String `tfsdk:"space_id"`
 
 	ResourceModel
+	SensitiveValueWriteOnlyModel
 }
 
 type VariablesDataSourceModel struct {
@@ -0,0 +1,115 @@
+package schemas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlySuffix and WriteOnlyVersionSuffix name the write-only variant of a secret attribute, such as password_wo,
+// and the attribute whose changes tell the provider to send it again, such as password_wo_version.
+const (
+	WriteOnlySuffix        = "_wo"
+	WriteOnlyVersionSuffix = "_wo_version"
+)
+
+// AddWriteOnlyAttributes adds a write-only variant, and its version, for each of the named secret attributes. The
+// secret may then be set with either attribute: a required secret becomes optional, and exactly one of the two must be
+// configured.
+func AddWriteOnlyAttributes(attributes map[string]resourceSchema.Attribute, names ...string) {
+	for _, name := range names {
+		secret, ok := attributes[name].(resourceSchema.StringAttribute)
+		if !ok {
+			panic(fmt.Sprintf("%s is not a string attribute and cannot have a write-only variant", name))
+		}
+
+		writeOnlyName := name + WriteOnlySuffix
+		versionName := name + WriteOnlyVersionSuffix
+
+		if secret.Required {
+			secret.Required = false
+			secret.Optional = true
+			secret.Validators = append(secret.Validators, stringvalidator.ExactlyOneOf(path.MatchRoot(writeOnlyName)))
+		} else {
+			secret.Validators = append(secret.Validators, stringvalidator.ConflictsWith(path.MatchRoot(writeOnlyName)))
+		}
+		attributes[name] = secret
+
+		attributes[writeOnlyName] = resourceSchema.StringAttribute{
+			Description: fmt.Sprintf("%s This value is write-only: it is sent to Octopus Deploy but never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Change `%s` to send a new value.", writeOnlyDescription(secret.Description, name), versionName),
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		}
+
+		attributes[versionName] = resourceSchema.Int64Attribute{
+			Description: fmt.Sprintf("A version for `%s`. Change it whenever `%s` changes, so the new value is sent to Octopus Deploy.", writeOnlyName, writeOnlyName),
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot(writeOnlyName)),
+			},
+		}
+	}
+}
+
+// WriteOnlyAttributes returns the secret attributes of a schema that have a write-only variant.
+func WriteOnlyAttributes(attributes map[string]resourceSchema.Attribute) []string {
+	var names []string
+	for name, attribute := range attributes {
+		if !strings.HasSuffix(name, WriteOnlySuffix) || !attribute.IsWriteOnly() {
+			continue
+		}
+
+		secret := strings.TrimSuffix(name, WriteOnlySuffix)
+		if _, ok := attributes[secret]; ok {
+			names = append(names, secret)
+		}
+	}
+	return names
+}
+
+func writeOnlyDescription(description string, name string) string {
+	if description == "" {
+		return fmt.Sprintf("The write-only variant of `%s`.", name)
+	}
+	return description
+}
+
+// PasswordWriteOnlyModel holds the write-only variant of a password attribute.
+type PasswordWriteOnlyModel struct {
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// SecretKeyWriteOnlyModel holds the write-only variant of a secret_key attribute.
+type SecretKeyWriteOnlyModel struct {
+	SecretKeyWo        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWoVersion types.Int64  `tfsdk:"secret_key_wo_version"`
+}
+
+// CertificateDataWriteOnlyModel holds the write-only variant of a certificate_data attribute.
+type CertificateDataWriteOnlyModel struct {
+	CertificateDataWo        types.String `tfsdk:"certificate_data_wo"`
+	CertificateDataWoVersion types.Int64  `tfsdk:"certificate_data_wo_version"`
+}
+
+// SensitiveValueWriteOnlyModel holds the write-only variant of a sensitive_value attribute.
+type SensitiveValueWriteOnlyModel struct {
+	SensitiveValueWo        types.String `tfsdk:"sensitive_value_wo"`
+	SensitiveValueWoVersion types.Int64  `tfsdk:"sensitive_value_wo_version"`
+}
+
+// ValueWriteOnlyModel holds the write-only variant of a value attribute.
+type ValueWriteOnlyModel struct {
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
+}
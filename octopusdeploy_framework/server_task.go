@@ -0,0 +1,144 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const serverTaskTemplate = "/api/{spaceId}/tasks{/id}"
+
+// serverTaskPollInterval is how often a server task is checked while waiting for it to complete.
+var serverTaskPollInterval = 5 * time.Second
+
+// serverTaskLogTailLines is how many lines of the task log are included when a server task fails.
+const serverTaskLogTailLines = 20
+
+const defaultServerTaskTimeout = 30 * time.Minute
+
+// parseServerTaskTimeout parses the timeout attribute of resources that wait for server tasks.
+func parseServerTaskTimeout(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultServerTaskTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("%q is not a positive duration such as 30m or 2h", value.ValueString())
+	}
+	return timeout, nil
+}
+
+// waitForServerTask polls a server task until it completes. It returns an error, ending with the tail of the task
+// log, when the task does not finish successfully or ctx is done first. The task details, which include the whole log,
+// are only loaded once the task has stopped being waited for.
+func waitForServerTask(ctx context.Context, taskID string, getTask func() (*tasks.Task, error), getDetails func() (*tasks.TaskDetailsResource, error)) error {
+	logTail := func() string {
+		details, err := getDetails()
+		if err != nil {
+			return ""
+		}
+		return serverTaskLogTail(details)
+	}
+
+	for {
+		task, err := getTask()
+		if err != nil {
+			return fmt.Errorf("unable to load server task %s: %w", taskID, err)
+		}
+
+		if task.IsCompleted != nil && *task.IsCompleted {
+			if task.FinishedSuccessfully != nil && *task.FinishedSuccessfully {
+				return nil
+			}
+			return fmt.Errorf("server task %s finished with state %s: %s%s", taskID, task.State, task.ErrorMessage, logTail())
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("server task %s did not complete in time and is still %s%s", taskID, task.State, logTail())
+		case <-time.After(serverTaskPollInterval):
+		}
+	}
+}
+
+// serverTaskLogTail returns the last lines of the task log, in the order they were written.
+func serverTaskLogTail(details *tasks.TaskDetailsResource) string {
+	var lines []string
+	var collect func(activities []*tasks.ActivityElement)
+	collect = func(activities []*tasks.ActivityElement) {
+		for _, activity := range activities {
+			if activity == nil {
+				continue
+			}
+			for _, element := range activity.LogElements {
+				lines = append(lines, fmt.Sprintf("%-8s %s", element.Category, element.MessageText))
+			}
+			collect(activity.Children)
+		}
+	}
+	collect(details.ActivityLogs)
+
+	if len(lines) == 0 {
+		return ""
+	}
+	if len(lines) > serverTaskLogTailLines {
+		lines = lines[len(lines)-serverTaskLogTailLines:]
+	}
+	return "\n\nTask log:\n" + strings.Join(lines, "\n")
+}
+
+// expandExecutionCommand converts the attributes shared by deployments and runbook runs into the command that starts
+// them.
+func expandExecutionCommand(ctx context.Context, model schemas.ServerTaskExecutionModel, spaceID string, projectID string) (deployments.CreateExecutionAbstractCommandV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	command := deployments.CreateExecutionAbstractCommandV1{
+		SpaceID:          spaceID,
+		ProjectIDOrName:  projectID,
+		UseGuidedFailure: expandGuidedFailureMode(model.GuidedFailureMode.ValueString()),
+	}
+
+	diags.Append(model.SkipStepNames.ElementsAs(ctx, &command.SkipStepNames, false)...)
+	diags.Append(model.SpecificMachineIDs.ElementsAs(ctx, &command.SpecificMachineNames, false)...)
+	diags.Append(model.ExcludedMachineIDs.ElementsAs(ctx, &command.ExcludedMachineNames, false)...)
+	diags.Append(model.Variables.ElementsAs(ctx, &command.Variables, false)...)
+	return command, diags
+}
+
+// expandGuidedFailureMode returns nil for EnvironmentDefault, so the server uses the setting of the environment.
+func expandGuidedFailureMode(mode string) *bool {
+	if mode != "On" && mode != "Off" {
+		return nil
+	}
+	useGuidedFailure := mode == "On"
+	return &useGuidedFailure
+}
+
+// waitForServerTasks waits for each server task in turn, until timeout elapses, reporting the tasks that do not
+// succeed. noun names what the tasks run, such as a deployment.
+func waitForServerTasks(ctx context.Context, client newclient.Client, spaceID string, taskIDs []string, timeout time.Duration, noun string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, taskID := range taskIDs {
+		err := waitForServerTask(ctx, taskID, func() (*tasks.Task, error) {
+			return newclient.GetByID[tasks.Task](client, serverTaskTemplate, spaceID, taskID)
+		}, func() (*tasks.TaskDetailsResource, error) {
+			return tasks.GetDetails(client, spaceID, taskID)
+		})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("the %s did not succeed", noun), err.Error())
+		}
+	}
+	return diags
+}
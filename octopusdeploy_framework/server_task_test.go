@@ -0,0 +1,119 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTask(state string, completed bool, succeeded bool) *tasks.Task {
+	return &tasks.Task{State: state, IsCompleted: &completed, FinishedSuccessfully: &succeeded}
+}
+
+func newTaskDetails(messages ...string) *tasks.TaskDetailsResource {
+	var logElements []*tasks.ActivityLogElement
+	for _, message := range messages {
+		logElements = append(logElements, &tasks.ActivityLogElement{Category: "Error", MessageText: message})
+	}
+
+	return &tasks.TaskDetailsResource{
+		ActivityLogs: []*tasks.ActivityElement{
+			{Name: "Deploy", Children: []*tasks.ActivityElement{{Name: "Step 1", LogElements: logElements}}},
+		},
+	}
+}
+
+func TestWaitForServerTaskPollsUntilComplete(t *testing.T) {
+	serverTaskPollInterval = time.Millisecond
+
+	polls := 0
+	err := waitForServerTask(context.Background(), "ServerTasks-1", func() (*tasks.Task, error) {
+		polls++
+		if polls < 3 {
+			return newTask("Executing", false, false), nil
+		}
+		return newTask("Success", true, true), nil
+	}, func() (*tasks.TaskDetailsResource, error) {
+		t.Fatal("the details of a successful task should not be loaded")
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, polls)
+}
+
+func TestWaitForServerTaskReportsLogTailOfFailedTask(t *testing.T) {
+	serverTaskPollInterval = time.Millisecond
+
+	var messages []string
+	for i := 1; i <= serverTaskLogTailLines+5; i++ {
+		messages = append(messages, fmt.Sprintf("line %d", i))
+	}
+
+	polls := 0
+	detailLoads := 0
+	err := waitForServerTask(context.Background(), "ServerTasks-1", func() (*tasks.Task, error) {
+		polls++
+		if polls < 3 {
+			return newTask("Executing", false, false), nil
+		}
+		return newTask("Failed", true, false), nil
+	}, func() (*tasks.TaskDetailsResource, error) {
+		detailLoads++
+		return newTaskDetails(messages...), nil
+	})
+
+	require.ErrorContains(t, err, "server task ServerTasks-1 finished with state Failed")
+	require.ErrorContains(t, err, fmt.Sprintf("line %d", serverTaskLogTailLines+5))
+	require.NotContains(t, err.Error(), "line 5\n")
+	require.Equal(t, 1, detailLoads)
+}
+
+func TestWaitForServerTaskStopsAtTimeout(t *testing.T) {
+	serverTaskPollInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	detailLoads := 0
+	err := waitForServerTask(ctx, "ServerTasks-1", func() (*tasks.Task, error) {
+		return newTask("Executing", false, false), nil
+	}, func() (*tasks.TaskDetailsResource, error) {
+		detailLoads++
+		return newTaskDetails("waiting for a target"), nil
+	})
+
+	require.ErrorContains(t, err, "did not complete in time and is still Executing")
+	require.ErrorContains(t, err, "waiting for a target")
+	require.Equal(t, 1, detailLoads)
+}
+
+func TestExpandExecutionCommand(t *testing.T) {
+	model := schemas.ServerTaskExecutionModel{
+		GuidedFailureMode:  types.StringValue("Off"),
+		SkipStepNames:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Smoke Test")}),
+		SpecificMachineIDs: types.SetNull(types.StringType),
+		ExcludedMachineIDs: types.SetNull(types.StringType),
+		Variables:          types.MapValueMust(types.StringType, map[string]attr.Value{"Password": types.StringValue("secret")}),
+	}
+
+	command, diags := expandExecutionCommand(context.Background(), model, "Spaces-1", "Projects-1")
+	require.False(t, diags.HasError())
+	require.Equal(t, "Spaces-1", command.SpaceID)
+	require.Equal(t, "Projects-1", command.ProjectIDOrName)
+	require.NotNil(t, command.UseGuidedFailure)
+	require.False(t, *command.UseGuidedFailure)
+	require.Equal(t, []string{"Smoke Test"}, command.SkipStepNames)
+	require.Empty(t, command.SpecificMachineNames)
+	require.Equal(t, map[string]string{"Password": "secret"}, command.Variables)
+
+	require.Nil(t, expandGuidedFailureMode("EnvironmentDefault"))
+	require.True(t, *expandGuidedFailureMode("On"))
+}